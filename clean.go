@@ -0,0 +1,185 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// bufPool stores buffers slugs are built in.
+var bufPool = sync.Pool{
+	New: func() interface{} {
+		buf := make([]byte, 0, 64)
+		return &buf
+	},
+}
+
+// maxPooledBuf limits capacity of buffers put back to bufPool, so one
+// long text doesn't keep a large buffer alive.
+const maxPooledBuf = 64 << 10
+
+// asciiSub is the part of language substitutions replacing ASCII chars.
+type asciiSub struct {
+	has [utf8.RuneSelf]bool
+	to  [utf8.RuneSelf]string
+}
+
+// canFuse reports whether the default stages can be run by runFused,
+// which gives the same slugs in fewer passes. It's used with the default
+// stages in ASCII mode, when the separator is one ASCII char which isn't
+// kept by the cleanup.
+func canFuse(c *Config, sep string) bool {
+	return len(c.Stages) == 0 && !c.Unicode && len(sep) == 1 && sep[0] < utf8.RuneSelf &&
+		!isAuthorized(sep[0]) && sep[0] != '_'
+}
+
+// runFused runs the default stages, doing the stages after
+// transliteration in one pass over a pooled buffer. Stages before
+// transliteration are skipped for plain ASCII text which they wouldn't
+// change.
+func (s *Slugger) runFused(text string, l *resolvedLanguage) string {
+	c := &s.cfg
+	cut := !c.EnableSmartTruncate && !c.HashTruncate
+	var slug string
+	if sub := s.fastSub(text, l); sub != nil {
+		limit := -1
+		if cut {
+			limit = c.MaxLength
+			if limit < 0 {
+				limit = 0
+			}
+		}
+		slug = s.clean(strings.TrimSpace(text), sub, limit)
+	} else {
+		for _, st := range defaultStages {
+			if st.name == StageLowercase {
+				break
+			}
+			text = st.fn(s, text, l)
+		}
+		// The text is ASCII now, so lower casing doesn't change its length
+		// and can be done after the cut.
+		if cut && s.length(text) >= c.MaxLength {
+			text = truncateLength(text, c.MaxLength, c.LengthUnit)
+		}
+		slug = s.clean(text, nil, -1)
+	}
+
+	if c.RemoveStopWords {
+		slug = s.removeStopWords(slug, s.stopWords(l))
+	}
+	return slug
+}
+
+// fastSub returns ASCII substitutions of the language if the stages
+// before transliteration can be skipped, or nil.
+func (s *Slugger) fastSub(text string, l *resolvedLanguage) *asciiSub {
+	c := &s.cfg
+	if len(c.CustomRuneSub) > 0 || len(c.CustomSub) > 0 || c.Symbols {
+		return nil
+	}
+	// Text can't be cut by graphemes or width while substitutions are made.
+	if !c.EnableSmartTruncate && !c.HashTruncate && c.LengthUnit != LengthBytes && c.LengthUnit != LengthRunes {
+		return nil
+	}
+	for i := 0; i < len(text); i++ {
+		if text[i] >= utf8.RuneSelf {
+			return nil
+		}
+	}
+	return l.asciiSub()
+}
+
+// clean does the lowercase, cleanup and trim-ends stages in one pass.
+// Chars of ASCII text are replaced with sub if it's not nil, and the text
+// is cut after limit bytes if limit isn't negative.
+func (s *Slugger) clean(text string, sub *asciiSub, limit int) string {
+	c := &s.cfg
+	b := cleaner{
+		sep:              s.sep[0],
+		lower:            c.Lowercase,
+		collapse:         !c.DisableMultipleDashTrim,
+		trim:             !c.DisableEndsTrim,
+		keepUnderscore:   c.Underscore == UnderscoreKeep,
+		removeUnderscore: c.Underscore == UnderscoreRemove,
+	}
+	bp := bufPool.Get().(*[]byte)
+	b.buf = (*bp)[:0]
+
+	for i := 0; i < len(text) && limit != 0; {
+		ch := text[i]
+		switch {
+		case ch >= utf8.RuneSelf:
+			// Not authorized, the whole rune becomes one separator.
+			_, size := utf8.DecodeRuneInString(text[i:])
+			b.add(utf8.RuneSelf)
+			i += size
+			continue
+		case sub != nil && sub.has[ch]:
+			for j := 0; j < len(sub.to[ch]) && limit != 0; j++ {
+				b.add(sub.to[ch][j])
+				limit--
+			}
+		default:
+			b.add(ch)
+			limit--
+		}
+		i++
+	}
+
+	if b.trim {
+		for len(b.buf) > 0 && (b.buf[len(b.buf)-1] == b.sep || b.buf[len(b.buf)-1] == '_') {
+			b.buf = b.buf[:len(b.buf)-1]
+		}
+	}
+	slug := text
+	if string(b.buf) != text {
+		slug = string(b.buf)
+	}
+	if cap(b.buf) <= maxPooledBuf {
+		*bp = b.buf
+		bufPool.Put(bp)
+	}
+	return slug
+}
+
+// cleaner builds slug char by char.
+type cleaner struct {
+	buf []byte
+	sep byte
+
+	lower, collapse, trim            bool
+	keepUnderscore, removeUnderscore bool
+}
+
+// add appends char to the slug, replacing chars which aren't authorized
+// with the separator. Chars from utf8.RuneSelf up stand for whole runes.
+func (b *cleaner) add(ch byte) {
+	switch {
+	case ch >= 'A' && ch <= 'Z' && b.lower:
+		ch += 'a' - 'A'
+	case isAuthorized(ch):
+	case ch == '_' && b.keepUnderscore:
+		if b.trim && len(b.buf) == 0 {
+			return
+		}
+	case ch == '_' && b.removeUnderscore:
+		return
+	default:
+		if b.trim && len(b.buf) == 0 || b.collapse && len(b.buf) > 0 && b.buf[len(b.buf)-1] == b.sep {
+			return
+		}
+		ch = b.sep
+	}
+	b.buf = append(b.buf, ch)
+}
+
+// isAuthorized reports whether ASCII char is kept by the cleanup.
+func isAuthorized(ch byte) bool {
+	return ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z' || ch >= '0' && ch <= '9'
+}
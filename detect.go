@@ -0,0 +1,235 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// latinLetterHints lists letters specific to some languages written in
+// Latin script, with weights used by DetectLanguage.
+var latinLetterHints = map[rune]map[string]float64{
+	'ß': {"de": 3},
+	'ä': {"de": 1, "fi": 1, "sv": 1},
+	'ö': {"de": 1, "fi": 1, "sv": 1, "hu": 1, "tr": 1},
+	'ü': {"de": 1, "hu": 1, "tr": 1},
+	'å': {"sv": 1.5, "nb": 1.5},
+	'æ': {"nb": 2},
+	'ø': {"nb": 2},
+	'ő': {"hu": 3},
+	'ű': {"hu": 3},
+	'ą': {"pl": 3},
+	'ę': {"pl": 3},
+	'ł': {"pl": 3},
+	'ś': {"pl": 3},
+	'ź': {"pl": 3},
+	'ż': {"pl": 3},
+	'ń': {"pl": 3},
+	'ć': {"pl": 2, "sl": 1},
+	'ě': {"cs": 3},
+	'ř': {"cs": 3},
+	'ů': {"cs": 3},
+	'ď': {"cs": 3},
+	'ť': {"cs": 3},
+	'ň': {"cs": 3},
+	'ý': {"cs": 2},
+	'č': {"cs": 1, "sl": 1},
+	'š': {"cs": 1, "sl": 1},
+	'ž': {"cs": 1, "sl": 1},
+	'đ': {"sl": 2},
+	'ș': {"ro": 3},
+	'ț': {"ro": 3},
+	'ă': {"ro": 3},
+	'ţ': {"ro": 2},
+	'ş': {"tr": 2, "ro": 1},
+	'ğ': {"tr": 3},
+	'ı': {"tr": 3},
+	'î': {"ro": 1, "fr": 0.5},
+	'â': {"ro": 1, "fr": 0.5, "pt": 0.5, "tr": 0.5},
+	'ç': {"fr": 1, "pt": 1, "tr": 1},
+	'ñ': {"es": 3},
+	'¿': {"es": 3},
+	'¡': {"es": 3},
+	'ã': {"pt": 3},
+	'õ': {"pt": 3},
+	'à': {"fr": 1, "it": 1, "pt": 0.5},
+	'è': {"fr": 1, "it": 1},
+	'é': {"fr": 1, "es": 0.5, "pt": 0.5, "it": 0.5, "hu": 0.5, "cs": 0.5},
+	'ê': {"fr": 1, "pt": 1},
+	'ë': {"fr": 1, "nl": 1},
+	'ï': {"fr": 1, "nl": 1},
+	'ù': {"fr": 1, "it": 1},
+	'ì': {"it": 2},
+	'ò': {"it": 2},
+	'á': {"es": 1, "pt": 1, "hu": 1, "cs": 1},
+	'í': {"es": 1, "pt": 1, "hu": 1, "cs": 1},
+	'ó': {"es": 1, "pt": 1, "hu": 1, "pl": 1},
+	'ú': {"es": 1, "pt": 0.5, "hu": 1, "cs": 1},
+}
+
+// kazakhLetters aren't used by other languages written in Cyrillic.
+const kazakhLetters = "әғқңөұүһі"
+
+// notBulgarianLetters are Cyrillic letters not used in Bulgarian.
+const notBulgarianLetters = "ыэёїєґў"
+
+// bulgarianHardSigns returns number of "ъ" used as a vowel, as in "път".
+// In Russian "ъ" is only a separator before "е", "ё", "ю" or "я", as in
+// "съезд".
+func bulgarianHardSigns(s string) float64 {
+	var n float64
+	for i, r := range s {
+		if r != 'ъ' {
+			continue
+		}
+		if next, _ := utf8.DecodeRuneInString(s[i+len("ъ"):]); !strings.ContainsRune("еёюя", next) {
+			n++
+		}
+	}
+	return n
+}
+
+// stopWordHint is weight of one stop word found in text.
+const stopWordHint = 0.5
+
+// DetectLanguage guesses language of the text from the dominant Unicode
+// script, and for Latin and Cyrillic scripts from language specific
+// letters and stop words. Cyrillic text is guessed to be Bulgarian only
+// from "ъ" used as a vowel and Bulgarian stop words, as Russian or
+// Serbian text isn't told apart otherwise.
+// It returns main code of a registered language
+// and confidence between 0.5 (tie with another language) and 1 (no other
+// candidate), or "", 0 when it can't tell.
+func DetectLanguage(s string) (lang string, confidence float64) {
+	s = strings.ToLower(s)
+
+	var latin, cyrillic, greek, other int
+	for _, r := range s {
+		switch {
+		case unicode.Is(unicode.Latin, r):
+			latin++
+		case unicode.Is(unicode.Cyrillic, r):
+			cyrillic++
+		case unicode.Is(unicode.Greek, r):
+			greek++
+		case unicode.IsLetter(r):
+			other++
+		}
+	}
+
+	scores := map[string]float64{}
+	switch {
+	case latin > cyrillic && latin > greek && latin > other:
+		for _, r := range s {
+			for l, w := range latinLetterHints[r] {
+				scores[l] += w
+			}
+		}
+	case cyrillic > greek && cyrillic > other:
+		switch {
+		case strings.ContainsAny(s, kazakhLetters):
+			scores["kk"] = 3
+		case strings.ContainsAny(s, notBulgarianLetters):
+			return "", 0
+		default:
+			// Other languages written in Cyrillic aren't registered, so
+			// Bulgarian is scored only from its own letters and stop words.
+			scores["bg"] = bulgarianHardSigns(s)
+		}
+	case greek > other:
+		return bestScore(map[string]float64{"gr": 1})
+	default:
+		return "", 0
+	}
+
+	for _, word := range strings.FieldsFunc(s, isNotLetter) {
+		for _, l := range stopWordLanguages(word) {
+			scores[l] += stopWordHint
+		}
+	}
+	return bestScore(scores)
+}
+
+// bestScore returns registered language with the highest score and
+// confidence comparing it with the runner-up: 1 when there is no other
+// candidate, 0.5 when two languages tie.
+func bestScore(scores map[string]float64) (lang string, confidence float64) {
+	langs := make([]string, 0, len(scores))
+	for l := range scores {
+		if lookupLanguage(l) != nil {
+			langs = append(langs, l)
+		}
+	}
+	// Sort, so ties are resolved the same way every time.
+	sort.Strings(langs)
+
+	var best, second float64
+	for _, l := range langs {
+		switch score := scores[l]; {
+		case score > best:
+			lang, best, second = l, score, best
+		case score > second:
+			second = score
+		}
+	}
+	if best == 0 {
+		return "", 0
+	}
+	return lang, best / (best + second)
+}
+
+// stopWordLanguages returns main codes of registered languages having
+// provided lower case word as stop word.
+func stopWordLanguages(word string) []string {
+	registry.RLock()
+	index := registry.stopWords
+	registry.RUnlock()
+	if index == nil {
+		registry.Lock()
+		if registry.stopWords == nil {
+			registry.stopWords = map[string][]string{}
+			for code, l := range registry.langs {
+				if code != l.codes[0] {
+					continue
+				}
+				for _, w := range l.stopWords {
+					w = strings.ToLower(w)
+					registry.stopWords[w] = append(registry.stopWords[w], code)
+				}
+			}
+		}
+		index = registry.stopWords
+		registry.Unlock()
+	}
+	return index[word]
+}
+
+func isNotLetter(r rune) bool {
+	return !unicode.IsLetter(r)
+}
+
+// MakeAuto returns slug generated from provided string using language
+// detected with DetectLanguage, and the main code of the used language.
+func MakeAuto(s string) (slug string, lang string) {
+	return globalSlugger().MakeAuto(s)
+}
+
+// MakeAuto returns slug generated from provided string using language
+// detected with DetectLanguage, and the main code of the used language.
+// If detection confidence is lower than Config.AutoConfidence, the default
+// language is used.
+func (s *Slugger) MakeAuto(text string) (slug string, lang string) {
+	l := s.defaultLanguage()
+	if code, confidence := DetectLanguage(text); code != "" && confidence >= s.cfg.AutoConfidence {
+		if detected := lookupLanguage(code); detected != nil {
+			l = detected
+		}
+	}
+	return s.makeLang(text, l), l.code
+}
@@ -0,0 +1,91 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"testing"
+)
+
+//=============================================================================
+
+func TestDetectLanguage(t *testing.T) {
+	testCases := []struct {
+		in   string
+		want string
+	}{
+		{"Wir mögen Bücher und Käse", "de"},
+		{"Straße", "de"},
+		{"Årets bästa böcker och filmer", "sv"},
+		{"Ærlig talt, Østen", "nb"},
+		{"Árvíztűrő tükörfúrógép", "hu"},
+		{"Zażółć gęślą jaźń", "pl"},
+		{"Příliš žluťoučký kůň", "cs"},
+		{"Țara și oamenii", "ro"},
+		{"Ağaç ılık", "tr"},
+		{"¿Dónde está el niño?", "es"},
+		{"Não há nada", "pt"},
+		{"Ça c'est très élégant", "fr"},
+		{"Perché così però", "it"},
+		{"The best guide to the Alps", "en"},
+		{"Ο οδηγός για τις Άλπεις", "gr"},
+		{"Това и онова", "bg"},
+		{"Қазақстан және әлем", "kk"},
+		{"Съешь же ещё этих мягких", ""},
+		{"Съезд партии", ""},
+		{"Объявление о продаже", ""},
+		{"Пътеводител", "bg"},
+		{"東京 旅行", ""},
+		{"Hello World", ""},
+		{"", ""},
+	}
+
+	for index, st := range testCases {
+		got, confidence := DetectLanguage(st.in)
+		if got != st.want {
+			t.Errorf(
+				"%d. DetectLanguage(%#v) = %#v, %v; want %#v",
+				index, st.in, got, confidence, st.want)
+		}
+		if confidence < 0 || confidence > 1 || (got == "") != (confidence == 0) {
+			t.Errorf(
+				"%d. DetectLanguage(%#v) confidence = %v",
+				index, st.in, confidence)
+		}
+	}
+}
+
+func TestSlugMakeAuto(t *testing.T) {
+	testCases := []struct {
+		threshold float64
+		in        string
+		want      string
+		wantLang  string
+	}{
+		{0, "Wir mögen Bücher & Käse", "wir-moegen-buecher-und-kaese", "de"},
+		{0, "Ærlig, Østen, Åse", "aerlig-oesten-aase", "nb"},
+		{0, "Ο οδηγός & Άλπεις", "o-odigos-kai-alpeis", "gr"},
+		{0, "Hello & World", "hello-and-world", "en"},
+		{0, "Käse", "kaese", "de"},
+		{0.6, "Käse", "kase", "en"},
+		{0.6, "Wir mögen Bücher & Käse", "wir-moegen-buecher-und-kaese", "de"},
+		{0.9, "Съезд партии", "s-ezd-partii", "en"},
+	}
+
+	for index, st := range testCases {
+		c := DefaultConfig()
+		c.AutoConfidence = st.threshold
+		got, lang := New(c).MakeAuto(st.in)
+		if got != st.want || lang != st.wantLang {
+			t.Errorf(
+				"%d. AutoConfidence = %v; MakeAuto(%#v) = %#v, %#v; want %#v, %#v",
+				index, st.threshold, st.in, got, lang, st.want, st.wantLang)
+		}
+	}
+
+	if got, lang := MakeAuto("Grüße & Küsse"); got != "gruesse-und-kuesse" || lang != "de" {
+		t.Errorf("MakeAuto(%#v) = %#v, %#v; want %#v, %#v", "Grüße & Küsse", got, lang, "gruesse-und-kuesse", "de")
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// EmojiMode defines how emoji are handled.
+type EmojiMode int
+
+const (
+	// EmojiKeep leaves emoji to the transliteration, which usually drops
+	// them.
+	EmojiKeep EmojiMode = iota
+	// EmojiName replaces emoji with their English CLDR short names, e.g.
+	// "🔥" with "fire" and "🇩🇪" with "flag-de". Emoji without a known
+	// name are removed. German names are bundled only for common single
+	// emoji; languages can set their own names with WithEmojiNames.
+	EmojiName
+	// EmojiRemove removes emoji, keeping words around them apart.
+	EmojiRemove
+)
+
+const (
+	zeroWidthJoiner   = '\u200D'
+	variationText     = '\uFE0E'
+	variationEmoji    = '\uFE0F'
+	combiningKeycap   = '\u20E3'
+	regionalIndicator = '\U0001F1E6' // A
+	tagCancel         = '\U000E007F'
+)
+
+// WithEmojiNames sets emoji names of the language used with EmojiName.
+// Keys are emoji, with or without variation selectors, values are names
+// written as in the text before transliteration. Emoji missing in the map
+// are named as in the parent language or in English.
+func WithEmojiNames(names map[string]string) LanguageOption {
+	return func(l *language) {
+		l.emoji = make(map[string]string, len(names))
+		for key, value := range names {
+			l.emoji[emojiKey(key)] = value
+		}
+	}
+}
+
+// replaceEmoji replaces emoji sequences in the text with their names, or
+// removes them if names is nil. Names and removed emoji are surrounded by
+// spaces, so they never join neighbouring words.
+func replaceEmoji(text string, names map[string]string) string {
+	start := -1
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		if isEmojiStart(r, text[i+size:]) {
+			start = i
+			break
+		}
+		i += size
+	}
+	if start < 0 {
+		return text
+	}
+
+	var b strings.Builder
+	b.Grow(len(text) + 16)
+	b.WriteString(text[:start])
+	for i := start; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		if !isEmojiStart(r, text[i+size:]) {
+			b.WriteString(text[i : i+size])
+			i += size
+			continue
+		}
+		n := emojiSequenceLen(text[i:])
+		b.WriteByte(' ')
+		if names != nil {
+			if name := emojiName(text[i:i+n], names); name != "" {
+				b.WriteString(name)
+				b.WriteByte(' ')
+			}
+		}
+		i += n
+	}
+	return b.String()
+}
+
+// emojiName returns name of the emoji sequence, built from names of its
+// parts if the whole sequence has no name.
+func emojiName(seq string, names map[string]string) string {
+	key := emojiKey(seq)
+	if name, ok := names[key]; ok {
+		return name
+	}
+
+	// Emoji with skin tones, e.g. "thumbs up: medium skin tone".
+	if strings.IndexFunc(key, isSkinTone) >= 0 {
+		var tones []string
+		base := strings.Map(func(r rune) rune {
+			if isSkinTone(r) {
+				tones = append(tones, names[string(r)])
+				return -1
+			}
+			return r
+		}, key)
+		name := emojiName(base, names)
+		if name == "" {
+			return ""
+		}
+		return name + ": " + strings.Join(tones, ", ")
+	}
+
+	if strings.ContainsRune(key, zeroWidthJoiner) {
+		parts := strings.Split(key, string(zeroWidthJoiner))
+		named := make([]string, 0, len(parts))
+		for _, part := range parts {
+			if name := emojiName(part, names); name != "" {
+				named = append(named, name)
+			}
+		}
+		return strings.Join(named, " ")
+	}
+
+	r, size := utf8.DecodeRuneInString(key)
+	rest := key[size:]
+	switch {
+	case isRegionalIndicator(r):
+		// Flags are named with the region code, e.g. "flag: DE".
+		code := []rune{r - regionalIndicator + 'A'}
+		if r2, _ := utf8.DecodeRuneInString(rest); isRegionalIndicator(r2) {
+			code = append(code, r2-regionalIndicator+'A')
+		}
+		return "flag: " + string(code)
+	case strings.HasSuffix(key, string(combiningKeycap)):
+		return "keycap: " + string(r)
+	case rest != "" && isTag(firstRune(rest)):
+		// Subdivision flags, e.g. "gbsct" for Scotland.
+		code := make([]rune, 0, 6)
+		for _, t := range rest {
+			if isTag(t) && t != tagCancel {
+				code = append(code, t-0xE0000)
+			}
+		}
+		return "flag: " + string(code)
+	}
+	return names[string(r)]
+}
+
+// emojiSequenceLen returns length in bytes of the emoji sequence at the
+// beginning of the text: the emoji with variation selectors, skin tones,
+// tags, keycap mark, and emoji joined with zero width joiners.
+func emojiSequenceLen(text string) int {
+	r, n := utf8.DecodeRuneInString(text)
+	if isRegionalIndicator(r) {
+		if r2, size := utf8.DecodeRuneInString(text[n:]); isRegionalIndicator(r2) {
+			n += size
+		}
+		return n
+	}
+	for n < len(text) {
+		r, size := utf8.DecodeRuneInString(text[n:])
+		switch {
+		case r == variationEmoji || r == variationText || r == combiningKeycap ||
+			isSkinTone(r) || isTag(r):
+			n += size
+		case r == zeroWidthJoiner:
+			next, nextSize := utf8.DecodeRuneInString(text[n+size:])
+			if !isEmoji(next) {
+				return n
+			}
+			n += size + nextSize
+		default:
+			return n
+		}
+	}
+	return n
+}
+
+// emojiKey returns emoji without variation selectors.
+func emojiKey(s string) string {
+	if !strings.ContainsAny(s, string(variationEmoji)+string(variationText)) {
+		return s
+	}
+	return strings.Map(func(r rune) rune {
+		if r == variationEmoji || r == variationText {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// isEmojiStart reports if rune r followed by rest starts an emoji sequence.
+// Digits, "#" and "*" are emoji only as keycaps, e.g. "1️⃣".
+func isEmojiStart(r rune, rest string) bool {
+	if r == '#' || r == '*' || r >= '0' && r <= '9' {
+		next, size := utf8.DecodeRuneInString(rest)
+		if next == variationEmoji {
+			next, _ = utf8.DecodeRuneInString(rest[size:])
+		}
+		return next == combiningKeycap
+	}
+	return isEmoji(r)
+}
+
+// isEmoji reports if rune is in one of the Unicode blocks of emoji.
+func isEmoji(r rune) bool {
+	switch {
+	case r >= 0x1F000 && r <= 0x1FAFF: // emoji, symbols and pictographs
+		return true
+	case r >= 0x2600 && r <= 0x27BF: // miscellaneous symbols, dingbats
+		return true
+	case r >= 0x2300 && r <= 0x23FF: // miscellaneous technical, e.g. "⌚"
+		return true
+	case r >= 0x2B00 && r <= 0x2BFF: // arrows and stars, e.g. "⭐"
+		return true
+	}
+	return false
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= regionalIndicator && r <= regionalIndicator+25
+}
+
+func isSkinTone(r rune) bool {
+	return r >= 0x1F3FB && r <= 0x1F3FF
+}
+
+func isTag(r rune) bool {
+	return r >= 0xE0020 && r <= tagCancel
+}
+
+func firstRune(s string) rune {
+	r, _ := utf8.DecodeRuneInString(s)
+	return r
+}
@@ -0,0 +1,89 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"testing"
+)
+
+//=============================================================================
+
+func TestSlugMakeEmoji(t *testing.T) {
+	testCases := []struct {
+		mode EmojiMode
+		lang string
+		in   string
+		want string
+	}{
+		{EmojiKeep, "en", "Summer Sale 🔥🏖️", "summer-sale"},
+		{EmojiKeep, "en", "Sale🔥Now", "salenow"},
+		{EmojiRemove, "en", "Sale🔥Now", "sale-now"},
+		{EmojiRemove, "en", "Family 👨‍👩‍👧 day 👍🏽", "family-day"},
+		{EmojiName, "en", "Summer Sale 🔥🏖️", "summer-sale-fire-beach-with-umbrella"},
+		{EmojiName, "en", "Summer Sale 🔥🏖", "summer-sale-fire-beach-with-umbrella"},
+		{EmojiName, "en", "I ❤️ Go", "i-red-heart-go"},
+		{EmojiName, "en", "👍🏽 nice", "thumbs-up-medium-skin-tone-nice"},
+		{EmojiName, "en", "👨‍👩‍👧 day", "family-man-woman-girl-day"},
+		{EmojiName, "en", "👩🏾‍💻 dev", "woman-technologist-medium-dark-skin-tone-dev"},
+		{EmojiName, "en", "👩‍🦰", "woman"},
+		{EmojiName, "en", "🏳️‍🌈 pride", "rainbow-flag-pride"},
+		{EmojiName, "en", "🇩🇪 Berlin", "flag-de-berlin"},
+		{EmojiName, "en", "🇩🇪🇫🇷", "flag-de-flag-fr"},
+		{EmojiName, "en", "🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F trip", "flag-scotland-trip"},
+		{EmojiName, "en", "1️⃣ 2⃣ #️⃣", "keycap-1-keycap-2-keycap-number-sign"},
+		{EmojiName, "en", "Room 101 #1", "room-101-1"},
+		{EmojiName, "en", "🫠 melt", "melt"},
+		{EmojiName, "de", "Summer Sale 🔥🏖️", "summer-sale-feuer-strand-mit-sonnenschirm"},
+		{EmojiName, "de", "👍🏻", "daumen-hoch-helle-hautfarbe"},
+		{EmojiName, "de", "🍔 & 🍟", "hamburger-und-french-fries"},
+		{EmojiName, "fr", "🔥 & 🍟", "fire-et-french-fries"},
+		{EmojiName, "de", "👨‍👩‍👧", "family-man-woman-girl"},
+		{EmojiName, "nb", "🔥", "fire"},
+	}
+
+	for index, st := range testCases {
+		c := DefaultConfig()
+		c.Emoji = st.mode
+		got := New(c).MakeLang(st.in, st.lang)
+		if got != st.want {
+			t.Errorf(
+				"%d. Emoji = %v; MakeLang(%#v, %#v) = %#v; want %#v",
+				index, st.mode, st.in, st.lang, got, st.want)
+		}
+	}
+}
+
+func TestSluggerMakeEmojiInvalidUTF8(t *testing.T) {
+	c := DefaultConfig()
+	c.Emoji = EmojiName
+	c.Stages = []StageOp{RemoveStage(StageCustomRuneSub)}
+	s := New(c)
+	for _, in := range []string{"x\xff", "\xff🔥", "🔥\xf0\x9f"} {
+		got := s.Make(in)
+		if !s.IsSlug(got) {
+			t.Errorf("Make(%#v) = %#v; want slug", in, got)
+		}
+	}
+}
+
+func TestRegisterLanguageEmoji(t *testing.T) {
+	RegisterLanguage([]string{"x-emoji"}, nil, WithEmojiNames(map[string]string{"❤️": "love"}))
+	RegisterLanguage([]string{"x-emoji-child"}, nil, WithParent("x-emoji"))
+
+	c := DefaultConfig()
+	c.Emoji = EmojiName
+	s := New(c)
+	for _, lang := range []string{"x-emoji", "x-emoji-child"} {
+		if got := s.MakeLang("I ❤ 🍕", lang); got != "i-love-pizza" {
+			t.Errorf("MakeLang(%#v, %#v) = %#v; want %#v", "I ❤ 🍕", lang, got, "i-love-pizza")
+		}
+	}
+
+	l, _ := LookupLanguage("x-emoji-child")
+	if got := l.Emoji["❤"]; got != "love" {
+		t.Errorf("LookupLanguage(%#v).Emoji[%#v] = %#v; want %#v", "x-emoji-child", "❤", got, "love")
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"strings"
+)
+
+// maxFilenameBytes is the file name limit of common filesystems, like ext4,
+// XFS, Btrfs or APFS.
+const maxFilenameBytes = 255
+
+// maxExtensionLength is the longest file extension recognized, without
+// the dot.
+const maxExtensionLength = 16
+
+// defaultFilename is used when the file name has no letters or numbers,
+// and added to names reserved by the filesystems.
+const defaultFilename = "file"
+
+// compressionExtensions are extensions joined with ".tar" before them,
+// e.g. ".tar.gz".
+var compressionExtensions = map[string]bool{
+	"br": true, "bz2": true, "gz": true, "lz": true, "lz4": true,
+	"lzma": true, "lzo": true, "xz": true, "z": true, "zst": true,
+}
+
+// reservedFilenames are names which can't be used on Windows, with or
+// without extension.
+var reservedFilenames = map[string]bool{
+	"con": true, "prn": true, "aux": true, "nul": true,
+	"com1": true, "com2": true, "com3": true, "com4": true, "com5": true,
+	"com6": true, "com7": true, "com8": true, "com9": true,
+	"lpt1": true, "lpt2": true, "lpt3": true, "lpt4": true, "lpt5": true,
+	"lpt6": true, "lpt7": true, "lpt8": true, "lpt9": true,
+}
+
+// MakeFilename returns file name generated from provided one, keeping its
+// extension, e.g. "Report Final.PDF" gives "report-final.pdf".
+// See Slugger.MakeFilename.
+func MakeFilename(name string) string {
+	return globalSlugger().MakeFilename(name)
+}
+
+// MakeFilename returns file name generated from provided one, keeping its
+// extension, e.g. "Report Final.PDF" gives "report-final.pdf" and
+// "Backup 2024.tar.gz" gives "backup-2024.tar.gz".
+// The extension counts towards MaxLength, it's dropped only if MaxLength
+// is too small to keep it. Result is never longer than 255 bytes.
+// Names without letters or numbers, like "." or "..", become "file", and
+// names reserved on Windows, like "CON" or "com1.txt", get "file" suffix,
+// e.g. "con-file.txt".
+func (s *Slugger) MakeFilename(name string) string {
+	c := &s.cfg
+	stem, ext := splitExtension(strings.TrimSpace(name))
+	if c.Lowercase {
+		ext = strings.ToLower(ext)
+	}
+
+	base, suffixes := s.makeParts(stem, s.language("en"))
+	if base == "" {
+		base = defaultFilename
+	}
+
+	unit := c.LengthUnit
+	if c.MaxLength > 0 && c.MaxLength <= textLength(ext, unit) {
+		ext = ""
+	}
+	fit := func(suffixes []string) string {
+		fitted := s.fitLength(base, c.MaxLength-textLength(ext, unit), unit, suffixes...)
+		if c.MaxLength <= 0 {
+			fitted = joinSuffix(base, strings.Join(suffixes, s.sep), s.sep)
+		}
+		if len(fitted)+len(ext) > maxFilenameBytes {
+			fitted = s.fitLength(base, maxFilenameBytes-len(ext), LengthBytes, suffixes...)
+		}
+		return fitted
+	}
+
+	stem = fit(suffixes)
+	if reservedFilenames[strings.ToLower(stem)] {
+		stem = fit(append([]string{defaultFilename}, suffixes...))
+	}
+	return stem + ext
+}
+
+// splitExtension splits file name into the stem and the extension with
+// the leading dot. Extension has only ASCII letters and digits, with at
+// least one letter, e.g. ".pdf" or ".mp3", and compressed tar archives keep
+// both parts, e.g. ".tar.gz". Hidden files like ".env" have no extension.
+func splitExtension(name string) (stem, ext string) {
+	i := strings.LastIndexByte(name, '.')
+	if i <= 0 || !isExtension(name[i+1:]) {
+		return name, ""
+	}
+	stem, ext = name[:i], name[i:]
+	if compressionExtensions[strings.ToLower(ext[1:])] {
+		if j := len(stem) - len(".tar"); j > 0 && strings.EqualFold(stem[j:], ".tar") {
+			stem, ext = stem[:j], stem[j:]+ext
+		}
+	}
+	return stem, ext
+}
+
+func isExtension(ext string) bool {
+	if ext == "" || len(ext) > maxExtensionLength {
+		return false
+	}
+	letter := false
+	for i := 0; i < len(ext); i++ {
+		c := ext[i] | 0x20 // lower case
+		switch {
+		case c >= 'a' && c <= 'z':
+			letter = true
+		case ext[i] >= '0' && ext[i] <= '9':
+		default:
+			return false
+		}
+	}
+	return letter
+}
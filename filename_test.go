@@ -0,0 +1,89 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"strings"
+	"testing"
+)
+
+//=============================================================================
+
+func TestSlugMakeFilename(t *testing.T) {
+	testCases := []struct {
+		in   string
+		want string
+	}{
+		{"Report Final.PDF", "report-final.pdf"},
+		{"Backup 2024.tar.gz", "backup-2024.tar.gz"},
+		{"Backup.TAR.XZ", "backup.tar.xz"},
+		{"archive.gz", "archive.gz"},
+		{"my.report.v2.docx", "my-report-v2.docx"},
+		{"Zażółć gęślą jaźń.txt", "zazolc-gesla-jazn.txt"},
+		{"song.mp3", "song.mp3"},
+		{"version 1.2", "version-1-2"},
+		{"Mr. Smith", "mr-smith"},
+		{"no extension", "no-extension"},
+		{".env", "env"},
+		{"file.", "file"},
+		{".", "file"},
+		{"..", "file"},
+		{"", "file"},
+		{"🔥.png", "file.png"},
+		{"CON", "con-file"},
+		{"con.txt", "con-file.txt"},
+		{"Com1.log", "com1-file.log"},
+		{"console.log", "console.log"},
+		{"lpt9", "lpt9-file"},
+		{"photo.veryveryverylongextension", "photo-veryveryverylongextension"},
+	}
+
+	for index, st := range testCases {
+		got := MakeFilename(st.in)
+		if got != st.want {
+			t.Errorf("%d. MakeFilename(%#v) = %#v; want %#v", index, st.in, got, st.want)
+		}
+	}
+}
+
+func TestSluggerMakeFilenameMaxLength(t *testing.T) {
+	testCases := []struct {
+		maxLength int
+		smart     bool
+		in        string
+		want      string
+	}{
+		{16, true, "Report Final.PDF", "report-final.pdf"},
+		{15, true, "Report Final.PDF", "report.pdf"},
+		{13, false, "Report Final.PDF", "report-fi.pdf"},
+		{14, true, "Backup 2024.tar.gz", "backup.tar.gz"},
+		{4, true, "Report Final.PDF", "repo"},
+		{8, true, "console.log", "cons.log"},
+		{7, true, "cons.txt", "fil.txt"},
+		{10, true, "con.txt", "c-file.txt"},
+		{12, true, "con.txt", "con-file.txt"},
+	}
+
+	for index, st := range testCases {
+		c := DefaultConfig()
+		c.MaxLength = st.maxLength
+		c.EnableSmartTruncate = st.smart
+		got := New(c).MakeFilename(st.in)
+		if got != st.want {
+			t.Errorf(
+				"%d. MaxLength = %v; MakeFilename(%#v) = %#v; want %#v",
+				index, st.maxLength, st.in, got, st.want)
+		}
+	}
+
+	long := strings.Repeat("ż", 300) + ".txt"
+	c := DefaultConfig()
+	c.Unicode = true
+	got := New(c).MakeFilename(long)
+	if len(got) > maxFilenameBytes || !strings.HasSuffix(got, "ż.txt") {
+		t.Errorf("MakeFilename(%#v) = %#v (%d bytes); want at most %d bytes", long, got, len(got), maxFilenameBytes)
+	}
+}
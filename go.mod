@@ -2,4 +2,7 @@ module github.com/gosimple/slug
 
 go 1.11
 
-require github.com/gosimple/unidecode v1.0.1
+require (
+	github.com/gosimple/unidecode v1.0.1
+	golang.org/x/text v0.13.0
+)
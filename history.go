@@ -0,0 +1,273 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Errors returned by History.
+var (
+	// ErrSlugTaken is returned when a slug is current or old slug of
+	// another entity.
+	ErrSlugTaken = errors.New("slug: slug is taken by another entity")
+	// ErrSlugNotFound is returned when a slug was never recorded.
+	ErrSlugNotFound = errors.New("slug: slug not found")
+	// ErrRedirectCycle is returned when redirects of a slug lead back to
+	// a slug already visited.
+	ErrRedirectCycle = errors.New("slug: redirect cycle")
+)
+
+// Record is a slug used by an entity, e.g. an article, since CreatedAt.
+type Record struct {
+	EntityID  string
+	Slug      string
+	CreatedAt time.Time
+}
+
+// HistoryStore keeps records of History. Records are kept separately for
+// every scope set with WithScope. The newest record is the one added last.
+type HistoryStore interface {
+	// Add saves the record. It returns ErrSlugTaken if the newest record of
+	// the slug belongs to another entity. Check and save must be atomic.
+	Add(ctx context.Context, r Record) error
+	// BySlug returns the newest record of the slug, or false if there is
+	// none.
+	BySlug(ctx context.Context, slug string) (Record, bool, error)
+	// ByEntity returns the newest record of the entity, or false if there
+	// is none.
+	ByEntity(ctx context.Context, entityID string) (Record, bool, error)
+	// Delete removes all records of the entity.
+	Delete(ctx context.Context, entityID string) error
+}
+
+// Resolution is the result of History.Resolve.
+type Resolution struct {
+	// EntityID is the entity using the slug.
+	EntityID string
+	// Slug is the current, canonical slug of the entity.
+	Slug string
+	// Chain lists slugs from the resolved one to the canonical one. It
+	// has more than one slug when the resolved slug should redirect.
+	Chain []string
+}
+
+// Redirect returns true if the resolved slug isn't the canonical one.
+func (r Resolution) Redirect() bool {
+	return len(r.Chain) > 1
+}
+
+// History records slugs used by entities, so old slugs can redirect to the
+// current ones. The current slug of an entity is its newest record. A slug
+// recorded by one entity can't be claimed by another, even after the first
+// one moved to a new slug, until the first one is forgotten.
+// History is also a Store, so MakeUnique with History never returns slugs
+// used now or before.
+type History struct {
+	// Store keeps the records.
+	Store HistoryStore
+	// Clock provides CreatedAt of new records. Default is the system clock.
+	Clock Clock
+}
+
+// NewHistory returns History keeping records in store.
+func NewHistory(store HistoryStore) *History {
+	return &History{Store: store}
+}
+
+// Record makes slug the current slug of the entity. Previous slugs of the
+// entity redirect to it. It returns ErrSlugTaken if slug was used by
+// another entity. Recording the current slug again does nothing.
+func (h *History) Record(ctx context.Context, entityID, slug string) error {
+	if slug == "" {
+		return &ValidationError{Err: ErrEmpty, Pos: -1}
+	}
+	current, ok, err := h.Store.ByEntity(ctx, entityID)
+	if err != nil {
+		return err
+	}
+	if ok && current.Slug == slug {
+		return nil
+	}
+	clock := h.Clock
+	if clock == nil {
+		clock = systemClock{}
+	}
+	return h.Store.Add(ctx, Record{EntityID: entityID, Slug: slug, CreatedAt: clock.Now()})
+}
+
+// Current returns the current slug of the entity, or ErrSlugNotFound if
+// the entity has no slug.
+func (h *History) Current(ctx context.Context, entityID string) (string, error) {
+	r, ok, err := h.Store.ByEntity(ctx, entityID)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", ErrSlugNotFound
+	}
+	return r.Slug, nil
+}
+
+// Resolve returns the canonical slug for provided current or old slug.
+// Old slugs of an entity point straight to its current slug, but records
+// written by other means may chain through several entities; they are
+// followed to the end. It returns ErrSlugNotFound if slug was never
+// recorded and ErrRedirectCycle if the chain loops.
+func (h *History) Resolve(ctx context.Context, slug string) (Resolution, error) {
+	res := Resolution{Chain: []string{slug}}
+	visited := map[string]bool{slug: true}
+	for {
+		owner, ok, err := h.Store.BySlug(ctx, slug)
+		if err != nil {
+			return Resolution{}, err
+		}
+		if !ok {
+			return Resolution{}, ErrSlugNotFound
+		}
+		current, ok, err := h.Store.ByEntity(ctx, owner.EntityID)
+		if err != nil {
+			return Resolution{}, err
+		}
+		if !ok || current.Slug == slug {
+			res.EntityID, res.Slug = owner.EntityID, slug
+			return res, nil
+		}
+		if visited[current.Slug] {
+			return Resolution{}, ErrRedirectCycle
+		}
+		visited[current.Slug] = true
+		slug = current.Slug
+		res.Chain = append(res.Chain, slug)
+	}
+}
+
+// Forget removes all records of the entity, so its slugs can be claimed
+// by other entities.
+func (h *History) Forget(ctx context.Context, entityID string) error {
+	return h.Store.Delete(ctx, entityID)
+}
+
+// Exists returns true if slug is current or old slug of any entity.
+func (h *History) Exists(ctx context.Context, slug string) (bool, error) {
+	_, ok, err := h.Store.BySlug(ctx, slug)
+	return ok, err
+}
+
+// MemoryHistoryStore is in-memory HistoryStore.
+// The zero value is an empty store ready to use.
+// MemoryHistoryStore is safe for concurrent use.
+type MemoryHistoryStore struct {
+	mu     sync.Mutex
+	scopes map[string]*historyScope
+}
+
+// historyScope keeps records of one scope with indexes of the newest
+// records of every slug and entity.
+type historyScope struct {
+	records  []Record
+	slugs    map[string]int
+	entities map[string]int
+}
+
+// NewMemoryHistoryStore returns empty MemoryHistoryStore.
+func NewMemoryHistoryStore() *MemoryHistoryStore {
+	return &MemoryHistoryStore{}
+}
+
+// Add saves the record in the context scope.
+func (m *MemoryHistoryStore) Add(ctx context.Context, r Record) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	scope := ScopeFromContext(ctx)
+	hs := m.scopes[scope]
+	if hs == nil {
+		hs = &historyScope{slugs: map[string]int{}, entities: map[string]int{}}
+		if m.scopes == nil {
+			m.scopes = make(map[string]*historyScope)
+		}
+		m.scopes[scope] = hs
+	}
+	if i, ok := hs.slugs[r.Slug]; ok && hs.records[i].EntityID != r.EntityID {
+		return ErrSlugTaken
+	}
+	hs.records = append(hs.records, r)
+	hs.slugs[r.Slug] = len(hs.records) - 1
+	hs.entities[r.EntityID] = len(hs.records) - 1
+	return nil
+}
+
+// BySlug returns the newest record of the slug in the context scope.
+func (m *MemoryHistoryStore) BySlug(ctx context.Context, slug string) (Record, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	hs := m.scopes[ScopeFromContext(ctx)]
+	if hs == nil {
+		return Record{}, false, nil
+	}
+	i, ok := hs.slugs[slug]
+	if !ok {
+		return Record{}, false, nil
+	}
+	return hs.records[i], true, nil
+}
+
+// ByEntity returns the newest record of the entity in the context scope.
+func (m *MemoryHistoryStore) ByEntity(ctx context.Context, entityID string) (Record, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	hs := m.scopes[ScopeFromContext(ctx)]
+	if hs == nil {
+		return Record{}, false, nil
+	}
+	i, ok := hs.entities[entityID]
+	if !ok {
+		return Record{}, false, nil
+	}
+	return hs.records[i], true, nil
+}
+
+// Records returns all records of the entity in the context scope, oldest
+// first.
+func (m *MemoryHistoryStore) Records(ctx context.Context, entityID string) []Record {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var records []Record
+	if hs := m.scopes[ScopeFromContext(ctx)]; hs != nil {
+		for _, r := range hs.records {
+			if r.EntityID == entityID {
+				records = append(records, r)
+			}
+		}
+	}
+	return records
+}
+
+// Delete removes all records of the entity in the context scope.
+func (m *MemoryHistoryStore) Delete(ctx context.Context, entityID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	hs := m.scopes[ScopeFromContext(ctx)]
+	if hs == nil {
+		return nil
+	}
+	records := hs.records[:0]
+	hs.slugs = map[string]int{}
+	hs.entities = map[string]int{}
+	for _, r := range hs.records {
+		if r.EntityID == entityID {
+			continue
+		}
+		records = append(records, r)
+		hs.slugs[r.Slug] = len(records) - 1
+		hs.entities[r.EntityID] = len(records) - 1
+	}
+	hs.records = records
+	return nil
+}
@@ -0,0 +1,220 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+//=============================================================================
+
+func TestHistoryResolve(t *testing.T) {
+	ctx := context.Background()
+	h := NewHistory(NewMemoryHistoryStore())
+	steps := []struct{ entity, slug string }{
+		{"1", "hello"},
+		{"1", "hello-world"},
+		{"1", "hello-new-world"},
+		{"2", "other"},
+		{"3", "back"},
+		{"3", "forth"},
+		{"3", "back"},
+	}
+	for index, st := range steps {
+		if err := h.Record(ctx, st.entity, st.slug); err != nil {
+			t.Fatalf("%d. Record(%#v, %#v) error = %v", index, st.entity, st.slug, err)
+		}
+	}
+
+	testCases := []struct {
+		in     string
+		entity string
+		chain  []string
+	}{
+		{"hello", "1", []string{"hello", "hello-new-world"}},
+		{"hello-world", "1", []string{"hello-world", "hello-new-world"}},
+		{"hello-new-world", "1", []string{"hello-new-world"}},
+		{"other", "2", []string{"other"}},
+		{"back", "3", []string{"back"}},
+		{"forth", "3", []string{"forth", "back"}},
+	}
+
+	for index, st := range testCases {
+		got, err := h.Resolve(ctx, st.in)
+		if err != nil {
+			t.Fatalf("%d. Resolve(%#v) error = %v", index, st.in, err)
+		}
+		want := Resolution{EntityID: st.entity, Slug: st.chain[len(st.chain)-1], Chain: st.chain}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%d. Resolve(%#v) = %+v; want %+v", index, st.in, got, want)
+		}
+		if got.Redirect() != (len(st.chain) > 1) {
+			t.Errorf("%d. Resolve(%#v).Redirect() = %v", index, st.in, got.Redirect())
+		}
+	}
+
+	if _, err := h.Resolve(ctx, "missing"); err != ErrSlugNotFound {
+		t.Errorf("Resolve(%#v) error = %v; want %v", "missing", err, ErrSlugNotFound)
+	}
+}
+
+func TestHistoryRecord(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryHistoryStore()
+	now := time.Date(2026, 10, 17, 20, 15, 30, 0, time.UTC)
+	h := &History{Store: store, Clock: ClockFunc(func() time.Time { return now })}
+
+	for _, slug := range []string{"old", "new", "new"} {
+		if err := h.Record(ctx, "1", slug); err != nil {
+			t.Fatalf("Record(%#v) error = %v", slug, err)
+		}
+	}
+	want := []Record{{"1", "old", now}, {"1", "new", now}}
+	if got := store.Records(ctx, "1"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Records() = %+v; want %+v", got, want)
+	}
+	if got, err := h.Current(ctx, "1"); got != "new" || err != nil {
+		t.Errorf("Current() = %#v, %v; want %#v", got, err, "new")
+	}
+
+	// Old slug still redirects, so other entities can't claim it.
+	for _, slug := range []string{"old", "new"} {
+		if err := h.Record(ctx, "2", slug); err != ErrSlugTaken {
+			t.Errorf("Record(%#v) error = %v; want %v", slug, err, ErrSlugTaken)
+		}
+	}
+	if err := h.Record(ctx, "2", ""); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Record(%#v) error = %v; want %v", "", err, ErrEmpty)
+	}
+	if _, err := h.Current(ctx, "2"); err != ErrSlugNotFound {
+		t.Errorf("Current() error = %v; want %v", err, ErrSlugNotFound)
+	}
+
+	// Forgotten entity frees its slugs.
+	if err := h.Forget(ctx, "1"); err != nil {
+		t.Fatalf("Forget() error = %v", err)
+	}
+	if err := h.Record(ctx, "2", "old"); err != nil {
+		t.Errorf("Record(%#v) error = %v", "old", err)
+	}
+	if _, err := h.Resolve(ctx, "new"); err != ErrSlugNotFound {
+		t.Errorf("Resolve(%#v) error = %v; want %v", "new", err, ErrSlugNotFound)
+	}
+}
+
+func TestHistoryScope(t *testing.T) {
+	h := NewHistory(NewMemoryHistoryStore())
+	a := WithScope(context.Background(), "a")
+	b := WithScope(context.Background(), "b")
+
+	if err := h.Record(a, "1", "hello"); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+	if err := h.Record(b, "2", "hello"); err != nil {
+		t.Errorf("Record() in other scope error = %v", err)
+	}
+}
+
+func TestHistoryMakeUnique(t *testing.T) {
+	ctx := context.Background()
+	h := NewHistory(NewMemoryHistoryStore())
+	if err := h.Record(ctx, "1", "hello-world"); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+	if err := h.Record(ctx, "1", "hello"); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+
+	got, err := MakeUnique(ctx, "Hello World", h)
+	if err != nil {
+		t.Fatalf("MakeUnique() error = %v", err)
+	}
+	if want := "hello-world-2"; got != want {
+		t.Errorf("MakeUnique(%#v) = %#v; want %#v", "Hello World", got, want)
+	}
+}
+
+// linkStore is HistoryStore with records set directly, without checks.
+type linkStore struct {
+	bySlug   map[string]Record
+	byEntity map[string]Record
+}
+
+func (l linkStore) Add(ctx context.Context, r Record) error {
+	l.bySlug[r.Slug], l.byEntity[r.EntityID] = r, r
+	return nil
+}
+
+func (l linkStore) BySlug(ctx context.Context, slug string) (Record, bool, error) {
+	r, ok := l.bySlug[slug]
+	return r, ok, nil
+}
+
+func (l linkStore) ByEntity(ctx context.Context, entityID string) (Record, bool, error) {
+	r, ok := l.byEntity[entityID]
+	return r, ok, nil
+}
+
+func (l linkStore) Delete(ctx context.Context, entityID string) error {
+	return nil
+}
+
+func TestHistoryResolveChain(t *testing.T) {
+	ctx := context.Background()
+	store := linkStore{bySlug: map[string]Record{}, byEntity: map[string]Record{}}
+	h := NewHistory(store)
+
+	// Slug "b" was taken over by entity 2, which moved to "c".
+	store.bySlug["a"] = Record{EntityID: "1", Slug: "a"}
+	store.byEntity["1"] = Record{EntityID: "1", Slug: "b"}
+	store.bySlug["b"] = Record{EntityID: "2", Slug: "b"}
+	store.byEntity["2"] = Record{EntityID: "2", Slug: "c"}
+	store.bySlug["c"] = Record{EntityID: "2", Slug: "c"}
+
+	got, err := h.Resolve(ctx, "a")
+	want := Resolution{EntityID: "2", Slug: "c", Chain: []string{"a", "b", "c"}}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("Resolve(%#v) = %+v, %v; want %+v", "a", got, err, want)
+	}
+
+	// Entity 2 points back to "a".
+	store.byEntity["2"] = Record{EntityID: "2", Slug: "a"}
+	if _, err := h.Resolve(ctx, "a"); err != ErrRedirectCycle {
+		t.Errorf("Resolve(%#v) error = %v; want %v", "a", err, ErrRedirectCycle)
+	}
+}
+
+func TestHistoryConcurrent(t *testing.T) {
+	ctx := context.Background()
+	h := NewHistory(NewMemoryHistoryStore())
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(entity string) {
+			defer wg.Done()
+			errs <- h.Record(ctx, entity, "hello")
+		}(string(rune('a' + i)))
+	}
+	wg.Wait()
+	close(errs)
+
+	ok := 0
+	for err := range errs {
+		if err == nil {
+			ok++
+		}
+	}
+	if ok != 1 {
+		t.Errorf("Record() succeeded %d times; want 1", ok)
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// HumanizeCase selects how Humanize capitalizes words.
+type HumanizeCase int
+
+const (
+	// SentenceCase capitalizes the first word only, e.g. "War and peace".
+	SentenceCase HumanizeCase = iota
+	// TitleCase capitalizes all words except small words of the language
+	// inside the title, e.g. "War and Peace".
+	TitleCase
+)
+
+// HumanizeOptions configures Humanize.
+type HumanizeOptions struct {
+	// Case selects capitalization. Default is SentenceCase.
+	Case HumanizeCase
+
+	// Language selects small words kept lower case by TitleCase, like
+	// "and" or "of" in English. Default is Config.DefaultLanguage.
+	Language string
+
+	// StripSuffixes removes suffixes added by the Slugger: the timestamp
+	// when AppendTimestamp is set, the hash when HashTruncate is set, and
+	// the counter added by MakeUnique when UniqueSuffix isn't set. As the
+	// counter is a plain number, it also removes a trailing number from 2
+	// to UniqueMaxAttempts+1 which was a part of the text, like in
+	// "iphone-15".
+	StripSuffixes bool
+
+	// Dictionary maps lower case words as they appear in slugs to their
+	// spelling, e.g. "cafe" to "café" or "iphone" to "iPhone". Keys may
+	// have several words separated with spaces, e.g. "new york".
+	// Replacements with upper case letters are kept as they are.
+	Dictionary map[string]string
+}
+
+// Humanize returns readable text restored from provided slug, e.g.
+// "hello-world" gives "Hello world". See Slugger.Humanize.
+func Humanize(slug string, opts HumanizeOptions) string {
+	return globalSlugger().Humanize(slug, opts)
+}
+
+// Humanize returns readable text restored from provided slug: words split
+// on the separator and underscores are joined with spaces and capitalized
+// as set by opts. Letters removed by transliteration can't be restored
+// without opts.Dictionary, e.g. "cafe" stays "Cafe".
+func (s *Slugger) Humanize(slug string, opts HumanizeOptions) string {
+	if opts.StripSuffixes {
+		slug = s.stripSuffixes(slug)
+	}
+	words := restoreWords(s.splitWords(slug), opts.Dictionary)
+
+	l := s.language(opts.Language)
+	small := l.smallWordsSet()
+	for i, w := range words {
+		if w.fixed {
+			continue
+		}
+		if i == 0 || opts.Case == TitleCase && (i == len(words)-1 || !small[strings.ToLower(w.text)]) {
+			words[i].text = capitalize(w.text, l.code)
+		}
+	}
+
+	texts := make([]string, len(words))
+	for i, w := range words {
+		texts[i] = w.text
+	}
+	return strings.Join(texts, " ")
+}
+
+// splitWords splits slug on the separator and underscores.
+func (s *Slugger) splitWords(slug string) []string {
+	words := []string{}
+	for _, part := range strings.Split(slug, s.sep) {
+		for _, w := range strings.Split(part, "_") {
+			if w != "" {
+				words = append(words, w)
+			}
+		}
+	}
+	return words
+}
+
+// stripSuffixes removes suffixes added by the Slugger, in the reverse
+// order: the counter, the timestamp and the hash. Suffix is never the
+// whole slug.
+func (s *Slugger) stripSuffixes(slug string) string {
+	c := &s.cfg
+	if c.UniqueSuffix == nil {
+		maxAttempts := c.UniqueMaxAttempts
+		if maxAttempts <= 0 {
+			maxAttempts = defaultUniqueMaxAttempts
+		}
+		slug = s.stripSuffix(slug, 1, func(suffix string) bool {
+			n, err := strconv.Atoi(suffix)
+			return err == nil && isDigits(suffix) && suffix[0] != '0' && n >= 2 && n <= maxAttempts+1
+		})
+	}
+	if c.AppendTimestamp {
+		ts := s.timestamp()
+		tsParts := strings.Split(ts, s.sep)
+		slug = s.stripSuffix(slug, len(tsParts), func(suffix string) bool {
+			return sameShape(strings.Split(suffix, s.sep), tsParts)
+		})
+	}
+	if c.HashTruncate {
+		hash := s.hash("")
+		alphabet := c.HashAlphabet
+		if alphabet == "" {
+			alphabet = base36
+		}
+		needDigit := strings.ContainsAny(alphabet, "0123456789")
+		slug = s.stripSuffix(slug, 1, func(suffix string) bool {
+			if len(suffix) != len(hash) || needDigit && !strings.ContainsAny(suffix, "0123456789") {
+				return false
+			}
+			for i := 0; i < len(suffix); i++ {
+				if strings.IndexByte(alphabet, suffix[i]) < 0 {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	return slug
+}
+
+// stripSuffix removes the last n words of the slug if match accepts them.
+func (s *Slugger) stripSuffix(slug string, n int, match func(suffix string) bool) string {
+	i := len(slug)
+	for ; n > 0 && i >= 0; n-- {
+		i = strings.LastIndex(slug[:i], s.sep)
+	}
+	if i <= 0 || !match(slug[i+len(s.sep):]) {
+		return slug
+	}
+	return slug[:i]
+}
+
+// sameShape reports whether parts have the same lengths as the timestamp
+// parts, and only digits where the timestamp parts have only digits.
+func sameShape(parts, tsParts []string) bool {
+	if len(parts) != len(tsParts) {
+		return false
+	}
+	for i, part := range parts {
+		if len(part) != len(tsParts[i]) || isDigits(tsParts[i]) && !isDigits(part) {
+			return false
+		}
+	}
+	return true
+}
+
+// humanWord is a word restored by Humanize. Fixed words keep their case.
+type humanWord struct {
+	text  string
+	fixed bool
+}
+
+// restoreWords replaces words and phrases found in the dictionary, longest
+// phrases first.
+func restoreWords(words []string, dict map[string]string) []humanWord {
+	maxWords := 0
+	for key := range dict {
+		if n := len(strings.Fields(key)); n > maxWords {
+			maxWords = n
+		}
+	}
+
+	restored := make([]humanWord, 0, len(words))
+	for i := 0; i < len(words); {
+		n := maxWords
+		if n > len(words)-i {
+			n = len(words) - i
+		}
+		for ; n > 0; n-- {
+			value, ok := dict[strings.ToLower(strings.Join(words[i:i+n], " "))]
+			if !ok {
+				continue
+			}
+			for _, w := range strings.Fields(value) {
+				restored = append(restored, humanWord{text: w, fixed: w != strings.ToLower(w)})
+			}
+			i += n
+			break
+		}
+		if n == 0 {
+			restored = append(restored, humanWord{text: words[i]})
+			i++
+		}
+	}
+	return restored
+}
+
+// capitalize returns word with the first letter in title case, following
+// rules of the language: Turkish dotted "i" becomes "İ" and Dutch "ij" is
+// capitalized as a single letter.
+func capitalize(word, code string) string {
+	r, size := utf8.DecodeRuneInString(word)
+	if r == utf8.RuneError {
+		return word
+	}
+	switch {
+	case code == "tr":
+		r = unicode.TurkishCase.ToTitle(r)
+	case code == "nl" && strings.HasPrefix(word, "ij"):
+		return "IJ" + word[2:]
+	default:
+		r = unicode.ToTitle(r)
+	}
+	return string(r) + word[size:]
+}
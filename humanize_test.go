@@ -0,0 +1,100 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"testing"
+	"time"
+)
+
+//=============================================================================
+
+func TestHumanize(t *testing.T) {
+	dict := map[string]string{
+		"cafe":     "café",
+		"iphone":   "iPhone",
+		"new york": "New York",
+		"fuer":     "für",
+	}
+
+	testCases := []struct {
+		in   string
+		opts HumanizeOptions
+		want string
+	}{
+		{"", HumanizeOptions{}, ""},
+		{"hello-world", HumanizeOptions{}, "Hello world"},
+		{"hello_world", HumanizeOptions{}, "Hello world"},
+		{"-hello--world-", HumanizeOptions{}, "Hello world"},
+		{"war-and-peace", HumanizeOptions{}, "War and peace"},
+		{"war-and-peace", HumanizeOptions{Case: TitleCase}, "War and Peace"},
+		{"the-lord-of-the-rings", HumanizeOptions{Case: TitleCase}, "The Lord of the Rings"},
+		{"what-are-you-looking-at", HumanizeOptions{Case: TitleCase}, "What Are You Looking At"},
+		{"herr-der-ringe", HumanizeOptions{Case: TitleCase, Language: "de"}, "Herr der Ringe"},
+		{"herr-der-ringe", HumanizeOptions{Case: TitleCase}, "Herr Der Ringe"},
+		{"le-petit-prince", HumanizeOptions{Case: TitleCase, Language: "fr"}, "Le Petit Prince"},
+		{"istanbul", HumanizeOptions{Language: "tr"}, "İstanbul"},
+		{"ijsselmeer", HumanizeOptions{Language: "nl"}, "IJsselmeer"},
+		{"cafe-in-new-york", HumanizeOptions{Case: TitleCase, Dictionary: dict}, "Café in New York"},
+		{"iphone-tips", HumanizeOptions{Dictionary: dict}, "iPhone tips"},
+		{"tipps-fuer-kinder", HumanizeOptions{Case: TitleCase, Language: "de", Dictionary: dict}, "Tipps für Kinder"},
+		{"hello-world-3", HumanizeOptions{}, "Hello world 3"},
+		{"hello-world-3", HumanizeOptions{StripSuffixes: true}, "Hello world"},
+		{"hello-world-102", HumanizeOptions{StripSuffixes: true}, "Hello world 102"},
+		{"hello-world-03", HumanizeOptions{StripSuffixes: true}, "Hello world 03"},
+		{"2", HumanizeOptions{StripSuffixes: true}, "2"},
+	}
+
+	for index, st := range testCases {
+		got := Humanize(st.in, st.opts)
+		if got != st.want {
+			t.Errorf("%d. Humanize(%#v, %+v) = %#v; want %#v", index, st.in, st.opts, got, st.want)
+		}
+	}
+}
+
+func TestSluggerHumanizeStripSuffixes(t *testing.T) {
+	now := time.Date(2026, 10, 17, 20, 15, 30, 0, time.UTC)
+
+	testCases := []struct {
+		format    TimestampFunc
+		hash      bool
+		maxLength int
+		in        string
+		want      string
+	}{
+		{nil, false, 0, "hello-world-1792268130", "Hello world"},
+		{nil, false, 0, "hello-world-1792268130-2", "Hello world"},
+		{nil, false, 0, "hello-world-179226813", "Hello world 179226813"},
+		{TimestampDateTime, false, 0, "hello-world-20251231-2359", "Hello world"},
+		{TimestampDateTime, false, 0, "hello-world-2359", "Hello world 2359"},
+		{nil, true, 30, "hello-wor-vli3f0zu-1792268130", "Hello wor"},
+		{nil, true, 30, "hello-tutorial-1792268130", "Hello tutorial"},
+	}
+
+	for index, st := range testCases {
+		c := DefaultConfig()
+		c.AppendTimestamp = true
+		c.TimestampFormat = st.format
+		c.Clock = ClockFunc(func() time.Time { return now })
+		c.HashTruncate = st.hash
+		c.MaxLength = st.maxLength
+		got := New(c).Humanize(st.in, HumanizeOptions{StripSuffixes: true})
+		if got != st.want {
+			t.Errorf("%d. Humanize(%#v) = %#v; want %#v", index, st.in, got, st.want)
+		}
+	}
+}
+
+func TestSluggerHumanizeSeparator(t *testing.T) {
+	c := DefaultConfig()
+	c.Separator = "."
+	c.Underscore = UnderscoreKeep
+	got := New(c).Humanize("snake_case.and.dots", HumanizeOptions{Case: TitleCase})
+	if want := "Snake Case and Dots"; got != want {
+		t.Errorf("Humanize(%#v) = %#v; want %#v", "snake_case.and.dots", got, want)
+	}
+}
@@ -0,0 +1,329 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gosimple/unidecode"
+)
+
+// Language describes substitution rules used for one language.
+type Language struct {
+	// Code is the main language code.
+	Code string
+	// Codes lists all codes the language is registered with.
+	Codes []string
+	// Parent is the code of the language this one inherits from, if any.
+	Parent string
+	// Sub is the rune substitution map, merged with the parent language and
+	// the default substitutions.
+	Sub map[rune]string
+	// StopWords lists stop words of the language, inherited from the parent
+	// language if the language has none.
+	StopWords []string
+	// SmallWords lists words kept lower case by Humanize with TitleCase,
+	// inherited from the parent language if the language has none. Stop
+	// words are used when there are no small words.
+	SmallWords []string
+	// Emoji maps emoji to their names, merged with the parent language and
+	// the English names.
+	Emoji map[string]string
+	// Symbols maps symbols and currency codes to words, merged with the
+	// parent language and the English words.
+	Symbols map[string]string
+}
+
+// LanguageOption configures language registered with RegisterLanguage.
+type LanguageOption func(*language)
+
+// WithParent makes the language inherit substitutions and stop words from
+// the language registered with provided code. The language's own rules
+// take precedence.
+func WithParent(code string) LanguageOption {
+	return func(l *language) {
+		l.parent = normalizeCode(code)
+	}
+}
+
+// WithStopWords sets stop words of the language, see Config.RemoveStopWords.
+func WithStopWords(words ...string) LanguageOption {
+	return func(l *language) {
+		l.stopWords = append([]string(nil), words...)
+	}
+}
+
+// WithSmallWords sets words kept lower case in titles, like "of" or "the",
+// see Humanize.
+func WithSmallWords(words ...string) LanguageOption {
+	return func(l *language) {
+		l.smallWords = append([]string(nil), words...)
+	}
+}
+
+// language stores language as registered.
+type language struct {
+	codes      []string
+	sub        map[rune]string
+	stopWords  []string
+	smallWords []string
+	emoji      map[string]string
+	symbols    map[string]string
+	parent     string
+}
+
+// resolvedLanguage is language merged with its parents and defaultSub.
+// It's never modified after creation, except for the lazy word sets.
+type resolvedLanguage struct {
+	code       string
+	codes      []string
+	parent     string
+	sub        map[rune]string
+	stopWords  []string
+	smallWords []string
+	emoji      map[string]string
+	symbols    map[string]string
+
+	stopOnce sync.Once
+	stopSet  map[string]bool
+
+	smallOnce sync.Once
+	smallSet  map[string]bool
+
+	symbolOnce sync.Once
+	symbolMap  map[rune]string
+
+	asciiOnce sync.Once
+	asciiMap  *asciiSub
+}
+
+var registry = struct {
+	sync.RWMutex
+	langs    map[string]*language
+	resolved map[string]*resolvedLanguage
+	// stopWords maps lower case stop words to main codes of languages
+	// using them. It's built lazily by stopWordLanguages.
+	stopWords map[string][]string
+}{
+	langs:    map[string]*language{},
+	resolved: map[string]*resolvedLanguage{},
+}
+
+// RegisterLanguage adds language with provided codes, or replaces already
+// registered one, so MakeLang(s, code) uses its substitutions.
+// The first code is the main one. Codes are case insensitive and "_" is
+// the same as "-".
+// Substitutions are merged with the default substitutions (quotes, dashes)
+// and with the parent language set by WithParent. Provided map is copied.
+// RegisterLanguage is safe to call while slugs are being made.
+func RegisterLanguage(codes []string, sub map[rune]string, opts ...LanguageOption) {
+	if len(codes) == 0 {
+		panic("slug: RegisterLanguage called without codes")
+	}
+	l := &language{sub: copyRuneSub(sub)}
+	for _, code := range codes {
+		l.codes = append(l.codes, normalizeCode(code))
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+	for _, code := range l.codes {
+		registry.langs[code] = l
+	}
+	registry.resolved = map[string]*resolvedLanguage{}
+	registry.stopWords = nil
+}
+
+// LookupLanguage returns language registered with provided code.
+func LookupLanguage(code string) (Language, bool) {
+	l := lookupLanguage(code)
+	if l == nil {
+		return Language{}, false
+	}
+	return Language{
+		Code:       l.code,
+		Codes:      append([]string(nil), l.codes...),
+		Parent:     l.parent,
+		Sub:        copyRuneSub(l.sub),
+		StopWords:  append([]string(nil), l.stopWords...),
+		SmallWords: append([]string(nil), l.smallWords...),
+		Emoji:      copySub(l.emoji),
+		Symbols:    copySub(l.symbols),
+	}, true
+}
+
+// Languages returns sorted main codes of all registered languages.
+func Languages() []string {
+	registry.RLock()
+	defer registry.RUnlock()
+	codes := []string{}
+	for code, l := range registry.langs {
+		if code == l.codes[0] {
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// lookupLanguage returns resolved language registered with provided code or
+// nil if there is none.
+func lookupLanguage(code string) *resolvedLanguage {
+	code = normalizeCode(code)
+	registry.RLock()
+	l, ok := registry.resolved[code]
+	_, registered := registry.langs[code]
+	registry.RUnlock()
+	if ok || !registered {
+		return l
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+	if l, ok := registry.resolved[code]; ok {
+		return l
+	}
+	l = resolveLanguage(code)
+	registry.resolved[code] = l
+	return l
+}
+
+// resolveLanguage merges language with its parents. Must be called with
+// registry locked.
+func resolveLanguage(code string) *resolvedLanguage {
+	l, ok := registry.langs[code]
+	if !ok {
+		return nil
+	}
+
+	// Collect the inheritance chain, stopping on unknown parents and cycles.
+	chain := []*language{l}
+	visited := map[*language]bool{l: true}
+	for p := l.parent; p != ""; {
+		parent, ok := registry.langs[p]
+		if !ok || visited[parent] {
+			break
+		}
+		visited[parent] = true
+		chain = append(chain, parent)
+		p = parent.parent
+	}
+
+	r := &resolvedLanguage{
+		code:    l.codes[0],
+		codes:   l.codes,
+		parent:  l.parent,
+		sub:     copyRuneSub(defaultSub),
+		emoji:   defaultEmoji,
+		symbols: defaultSymbols,
+	}
+	var ownEmoji, ownSymbols bool
+	for i := len(chain) - 1; i >= 0; i-- {
+		r.emoji = mergeNames(r.emoji, chain[i].emoji, &ownEmoji)
+		r.symbols = mergeNames(r.symbols, chain[i].symbols, &ownSymbols)
+		for key, value := range chain[i].sub {
+			r.sub[key] = value
+		}
+		if chain[i].stopWords != nil {
+			r.stopWords = chain[i].stopWords
+		}
+		if chain[i].smallWords != nil {
+			r.smallWords = chain[i].smallWords
+		}
+	}
+	return r
+}
+
+// mergeNames adds names to the shared map dst, copying it first, so
+// languages without own names share the defaults.
+func mergeNames(dst, names map[string]string, copied *bool) map[string]string {
+	if names == nil {
+		return dst
+	}
+	if !*copied {
+		dst, *copied = copySub(dst), true
+	}
+	for key, value := range names {
+		dst[key] = value
+	}
+	return dst
+}
+
+// stopWordsSet returns transliterated stop words of the language.
+func (l *resolvedLanguage) stopWordsSet() map[string]bool {
+	l.stopOnce.Do(func() {
+		l.stopSet = transliterateWords(l.stopWords, l.sub)
+	})
+	return l.stopSet
+}
+
+// smallWordsSet returns lower case small words of the language, both as
+// written and transliterated, so they match slugs made in any mode.
+func (l *resolvedLanguage) smallWordsSet() map[string]bool {
+	l.smallOnce.Do(func() {
+		words := l.smallWords
+		if words == nil {
+			words = l.stopWords
+		}
+		l.smallSet = transliterateWords(words, l.sub)
+		for _, w := range words {
+			l.smallSet[strings.ToLower(w)] = true
+		}
+	})
+	return l.smallSet
+}
+
+// symbolSub returns substitutions of the language for runes other than
+// letters and numbers, used in Unicode mode.
+func (l *resolvedLanguage) symbolSub() map[rune]string {
+	l.symbolOnce.Do(func() {
+		l.symbolMap = map[rune]string{}
+		for key, value := range l.sub {
+			if !unicode.IsLetter(key) && !unicode.IsNumber(key) {
+				l.symbolMap[key] = value
+			}
+		}
+	})
+	return l.symbolMap
+}
+
+// asciiSub returns substitutions of the language for ASCII chars, or nil
+// if some of them give non ASCII text.
+func (l *resolvedLanguage) asciiSub() *asciiSub {
+	l.asciiOnce.Do(func() {
+		sub := &asciiSub{}
+		for key, value := range l.sub {
+			if key >= utf8.RuneSelf {
+				continue
+			}
+			for i := 0; i < len(value); i++ {
+				if value[i] >= utf8.RuneSelf {
+					return
+				}
+			}
+			sub.has[key], sub.to[key] = true, value
+		}
+		l.asciiMap = sub
+	})
+	return l.asciiMap
+}
+
+// transliterateWords returns set of words transliterated the same way as
+// slugs are.
+func transliterateWords(words []string, sub map[rune]string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, w := range words {
+		set[strings.ToLower(unidecode.Unidecode(SubstituteRune(w, sub)))] = true
+	}
+	return set
+}
@@ -0,0 +1,139 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"strings"
+)
+
+// Tag is language tag parsed from BCP 47 ("de-AT", "sr-Latn",
+// "zh-Hant-TW") or POSIX locale ("de_CH", "pt_BR.UTF-8") form.
+type Tag struct {
+	// Language is lower case language subtag, e.g. "de".
+	Language string
+	// Script is title case script subtag, e.g. "Latn".
+	Script string
+	// Region is upper case region subtag, e.g. "AT" or "419".
+	Region string
+}
+
+// ParseTag parses BCP 47 language tag or POSIX locale name. Variants,
+// extensions, encodings and modifiers are ignored. Unknown parts never
+// cause an error, they are skipped.
+func ParseTag(s string) Tag {
+	s = normalizeCode(s)
+	// POSIX locale: language_REGION.encoding@modifier
+	if i := strings.IndexAny(s, ".@"); i >= 0 {
+		s = s[:i]
+	}
+
+	var t Tag
+	for i, part := range strings.Split(s, "-") {
+		switch {
+		case i == 0:
+			if isAlpha(part) {
+				t.Language = part
+			}
+		case len(part) == 1:
+			// Extensions and private use subtags end the interesting part.
+			return t
+		case len(part) == 3 && isAlpha(part) && t.Script == "" && t.Region == "":
+			// Extended language subtag, e.g. "zh-yue", is the language.
+			t.Language = part
+		case len(part) == 4 && isAlpha(part) && t.Script == "" && t.Region == "":
+			t.Script = strings.ToUpper(part[:1]) + part[1:]
+		case (len(part) == 2 && isAlpha(part) || len(part) == 3 && isDigits(part)) && t.Region == "":
+			t.Region = strings.ToUpper(part)
+		}
+	}
+	return t
+}
+
+// String returns tag in BCP 47 form, e.g. "zh-Hant-TW".
+func (t Tag) String() string {
+	s := t.Language
+	if t.Script != "" {
+		s += "-" + t.Script
+	}
+	if t.Region != "" {
+		s += "-" + t.Region
+	}
+	return s
+}
+
+// Fallbacks returns lower case codes tried when looking for the tag
+// language, from the most to the least specific one,
+// e.g. "zh-hant-tw", "zh-hant", "zh-tw", "zh".
+func (t Tag) Fallbacks() []string {
+	if t.Language == "" {
+		return nil
+	}
+	lang := t.Language
+	script := strings.ToLower(t.Script)
+	region := strings.ToLower(t.Region)
+
+	var codes []string
+	if script != "" && region != "" {
+		codes = append(codes, lang+"-"+script+"-"+region)
+	}
+	if script != "" {
+		codes = append(codes, lang+"-"+script)
+	}
+	if region != "" {
+		codes = append(codes, lang+"-"+region)
+	}
+	return append(codes, lang)
+}
+
+// ResolveLanguage returns main code of the registered language matching
+// the first possible of provided tags. Every tag is tried with its
+// fallbacks, e.g. "de-AT" then "de", before the next tag.
+// If no tag matches, it returns "", false.
+func ResolveLanguage(tags ...string) (string, bool) {
+	if l := resolveTags(tags); l != nil {
+		return l.code, true
+	}
+	return "", false
+}
+
+// resolveTags returns language matching the first possible of provided
+// tags, or nil.
+func resolveTags(tags []string) *resolvedLanguage {
+	for _, tag := range tags {
+		if l := lookupLanguage(tag); l != nil {
+			return l
+		}
+		for _, code := range ParseTag(tag).Fallbacks() {
+			if l := lookupLanguage(code); l != nil {
+				return l
+			}
+		}
+	}
+	return nil
+}
+
+// normalizeCode returns lower case code with "-" as subtags separator.
+func normalizeCode(code string) string {
+	return strings.Replace(strings.ToLower(strings.TrimSpace(code)), "_", "-", -1)
+}
+
+func isAlpha(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'a' || s[i] > 'z' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,140 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"reflect"
+	"testing"
+)
+
+//=============================================================================
+
+func TestParseTag(t *testing.T) {
+	testCases := []struct {
+		in        string
+		want      Tag
+		fallbacks []string
+	}{
+		{"de", Tag{"de", "", ""}, []string{"de"}},
+		{"DE-at", Tag{"de", "", "AT"}, []string{"de-at", "de"}},
+		{"de_CH", Tag{"de", "", "CH"}, []string{"de-ch", "de"}},
+		{"pt_BR.UTF-8", Tag{"pt", "", "BR"}, []string{"pt-br", "pt"}},
+		{"ca_ES@valencia", Tag{"ca", "", "ES"}, []string{"ca-es", "ca"}},
+		{"sr-Latn", Tag{"sr", "Latn", ""}, []string{"sr-latn", "sr"}},
+		{"zh-Hant-TW", Tag{"zh", "Hant", "TW"}, []string{"zh-hant-tw", "zh-hant", "zh-tw", "zh"}},
+		{"es-419", Tag{"es", "", "419"}, []string{"es-419", "es"}},
+		{"zh-yue-HK", Tag{"yue", "", "HK"}, []string{"yue-hk", "yue"}},
+		{"sl-rozaj-biske", Tag{"sl", "", ""}, []string{"sl"}},
+		{"en-US-x-twain", Tag{"en", "", "US"}, []string{"en-us", "en"}},
+		{" fr-CA ", Tag{"fr", "", "CA"}, []string{"fr-ca", "fr"}},
+		{"", Tag{}, nil},
+		{"1234", Tag{}, nil},
+	}
+
+	for index, st := range testCases {
+		got := ParseTag(st.in)
+		if got != st.want {
+			t.Errorf("%d. ParseTag(%#v) = %#v; want %#v", index, st.in, got, st.want)
+		}
+		if fb := got.Fallbacks(); !reflect.DeepEqual(fb, st.fallbacks) {
+			t.Errorf("%d. ParseTag(%#v).Fallbacks() = %#v; want %#v", index, st.in, fb, st.fallbacks)
+		}
+	}
+
+	if got := ParseTag("zh_hant_tw").String(); got != "zh-Hant-TW" {
+		t.Errorf("ParseTag(%#v).String() = %#v; want %#v", "zh_hant_tw", got, "zh-Hant-TW")
+	}
+}
+
+func TestSlugMakeLangTag(t *testing.T) {
+	testCases := []struct {
+		lang string
+		in   string
+		want string
+	}{
+		{"de-AT", "Käse & Brot", "kaese-und-brot"},
+		{"de_CH", "Käse & Brot", "kaese-und-brot"},
+		{"de_DE.UTF-8", "Käse & Brot", "kaese-und-brot"},
+		{"pt_BR", "This & that", "this-e-that"},
+		{"pt-PT", "This & that", "this-e-that"},
+		{"sv-Latn-FI", "This & that", "this-och-that"},
+		{"el-GR", "This & that", "this-kai-that"},
+		{"zh-Hant-TW", "This & that", "this-and-that"},
+		{"xx-YY", "This & that", "this-and-that"},
+	}
+
+	for index, st := range testCases {
+		got := MakeLang(st.in, st.lang)
+		if got != st.want {
+			t.Errorf(
+				"%d. MakeLang(%#v, %#v) = %#v; want %#v",
+				index, st.in, st.lang, got, st.want)
+		}
+	}
+}
+
+func TestSlugMakeLangs(t *testing.T) {
+	testCases := []struct {
+		langs    []string
+		in       string
+		want     string
+		wantLang string
+	}{
+		{[]string{"de-AT", "fr"}, "This & that", "this-und-that", "de"},
+		{[]string{"xx", "fr-CA", "de"}, "This & that", "this-et-that", "fr"},
+		{[]string{"pt-BR"}, "This & that", "this-e-that", "pt-br"},
+		{[]string{"nno"}, "This & that", "this-og-that", "nn"},
+		{[]string{"xx", "yy"}, "This & that", "this-and-that", "en"},
+		{nil, "This & that", "this-and-that", "en"},
+	}
+
+	for index, st := range testCases {
+		got, lang := MakeLangs(st.in, st.langs...)
+		if got != st.want || lang != st.wantLang {
+			t.Errorf(
+				"%d. MakeLangs(%#v, %#v) = %#v, %#v; want %#v, %#v",
+				index, st.in, st.langs, got, lang, st.want, st.wantLang)
+		}
+	}
+}
+
+func TestResolveLanguage(t *testing.T) {
+	testCases := []struct {
+		tags   []string
+		want   string
+		wantOk bool
+	}{
+		{[]string{"de-AT"}, "de", true},
+		{[]string{"ELL"}, "gr", true},
+		{[]string{"xx", "sl_SI"}, "sl", true},
+		{[]string{"xx"}, "", false},
+	}
+
+	for index, st := range testCases {
+		got, ok := ResolveLanguage(st.tags...)
+		if got != st.want || ok != st.wantOk {
+			t.Errorf(
+				"%d. ResolveLanguage(%#v) = %#v, %v; want %#v, %v",
+				index, st.tags, got, ok, st.want, st.wantOk)
+		}
+	}
+}
+
+func TestSluggerDefaultLanguage(t *testing.T) {
+	c := DefaultConfig()
+	c.DefaultLanguage = "de_AT"
+	s := New(c)
+
+	if got := s.MakeLang("This & that", "xx"); got != "this-und-that" {
+		t.Errorf("MakeLang(%#v, %#v) = %#v; want %#v", "This & that", "xx", got, "this-und-that")
+	}
+	if got, lang := s.MakeLangs("This & that", "xx"); got != "this-und-that" || lang != "de" {
+		t.Errorf("MakeLangs(%#v, %#v) = %#v, %#v; want %#v, %#v", "This & that", "xx", got, lang, "this-und-that", "de")
+	}
+	if got := s.MakeLang("This & that", "fr"); got != "this-et-that" {
+		t.Errorf("MakeLang(%#v, %#v) = %#v; want %#v", "This & that", "fr", got, "this-et-that")
+	}
+}
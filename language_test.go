@@ -0,0 +1,118 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+//=============================================================================
+
+func TestRegisterLanguage(t *testing.T) {
+	RegisterLanguage([]string{"x-pirate", "X-ARR"}, map[rune]string{'&': "an'", 'ä': "arr"})
+	RegisterLanguage([]string{"x-pirate-child"}, map[rune]string{'@': "aboard"}, WithParent("x-pirate"))
+	RegisterLanguage([]string{"x-override"}, map[rune]string{'&': "first"})
+	RegisterLanguage([]string{"x-override"}, map[rune]string{'&': "second"})
+	RegisterLanguage([]string{"x-dashes"}, map[rune]string{'–': "to"})
+
+	testCases := []struct {
+		lang string
+		in   string
+		want string
+	}{
+		{"x-pirate", "Ships & Gäld", "ships-an-garrld"},
+		{"x-arr", "Ships & Gäld", "ships-an-garrld"},
+		{"X-Pirate", "Ships & Gäld", "ships-an-garrld"},
+		{"x-pirate-child", "Ships & Gäld @ sea", "ships-an-garrld-aboard-sea"},
+		{"x-pirate", "Ships @ sea", "ships-sea"},
+		{"x-override", "This & that", "this-second-that"},
+		{"x-dashes", "1–2 'quoted'", "1to2-quoted"},
+	}
+
+	for index, st := range testCases {
+		got := MakeLang(st.in, st.lang)
+		if got != st.want {
+			t.Errorf(
+				"%d. MakeLang(%#v, %#v) = %#v; want %#v",
+				index, st.in, st.lang, got, st.want)
+		}
+	}
+
+	if got := defaultSub['–']; got != "-" {
+		t.Errorf("defaultSub['–'] = %#v; want %#v", got, "-")
+	}
+}
+
+func TestLookupLanguage(t *testing.T) {
+	nn, ok := LookupLanguage("NNO")
+	if !ok {
+		t.Fatalf("LookupLanguage(%#v) not found", "NNO")
+	}
+	if nn.Code != "nn" || nn.Parent != "nb" {
+		t.Errorf("LookupLanguage(%#v) = %v, parent %v; want nn, parent nb", "NNO", nn.Code, nn.Parent)
+	}
+	if got := nn.Sub['ø']; got != "oe" {
+		t.Errorf("LookupLanguage(%#v).Sub['ø'] = %#v; want %#v", "nn", got, "oe")
+	}
+	if got := nn.Sub['’']; got != "" {
+		t.Errorf("LookupLanguage(%#v).Sub['’'] = %#v; want %#v", "nn", got, "")
+	}
+
+	// Returned language is a copy.
+	nn.Sub['ø'] = "o"
+	if got := MakeLang("Østen", "nn"); got != "oesten" {
+		t.Errorf("MakeLang(%#v, %#v) = %#v; want %#v", "Østen", "nn", got, "oesten")
+	}
+
+	ptBR, _ := LookupLanguage("pt-BR")
+	if got := ptBR.StopWords; !reflect.DeepEqual(got, ptStopWords) {
+		t.Errorf("LookupLanguage(%#v).StopWords = %v; want %v", "pt-BR", got, ptStopWords)
+	}
+
+	if _, ok := LookupLanguage("test"); ok {
+		t.Errorf("LookupLanguage(%#v) found; want not found", "test")
+	}
+}
+
+func TestLanguages(t *testing.T) {
+	langs := Languages()
+	want := []string{
+		"bg", "cs", "de", "en", "es", "fi", "fr", "gr", "hu", "id", "it", "kk",
+		"nb", "nl", "nn", "pl", "pt", "pt-br", "ro", "sl", "sv", "tr",
+	}
+	got := map[string]bool{}
+	for _, code := range langs {
+		got[code] = true
+	}
+	for _, code := range want {
+		if !got[code] {
+			t.Errorf("Languages() = %v; missing %#v", langs, code)
+		}
+	}
+	if got["deu"] || got["el"] {
+		t.Errorf("Languages() = %v; want only main codes", langs)
+	}
+}
+
+func TestRegisterLanguageConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			RegisterLanguage([]string{"x-concurrent"}, map[rune]string{'&': "and"})
+		}()
+		go func() {
+			defer wg.Done()
+			if got := MakeLang("This & that", "x-concurrent"); got != "this-and-that" {
+				t.Errorf("MakeLang() = %#v; want %#v", got, "this-and-that")
+			}
+		}()
+	}
+	wg.Wait()
+}
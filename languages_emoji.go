@@ -0,0 +1,536 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+// Emoji names below are CLDR short names of common emoji, used with
+// EmojiName. Sequences are written with escapes, keys never contain
+// variation selectors. Names of emoji sequences missing here are built
+// from names of their parts, e.g. emoji with skin tone.
+
+// defaultEmoji are English names used by languages without own names.
+var defaultEmoji = map[string]string{
+	"😀":       "grinning face",
+	"😃":       "grinning face with big eyes",
+	"😄":       "grinning face with smiling eyes",
+	"😁":       "beaming face with smiling eyes",
+	"😆":       "grinning squinting face",
+	"😅":       "grinning face with sweat",
+	"🤣":       "rolling on the floor laughing",
+	"😂":       "face with tears of joy",
+	"🙂":       "slightly smiling face",
+	"🙃":       "upside-down face",
+	"😉":       "winking face",
+	"😊":       "smiling face with smiling eyes",
+	"😇":       "smiling face with halo",
+	"🥰":       "smiling face with hearts",
+	"😍":       "smiling face with heart-eyes",
+	"🤩":       "star-struck",
+	"😘":       "face blowing a kiss",
+	"😋":       "face savoring food",
+	"😛":       "face with tongue",
+	"😜":       "winking face with tongue",
+	"🤪":       "zany face",
+	"🤔":       "thinking face",
+	"🤗":       "smiling face with open hands",
+	"🤫":       "shushing face",
+	"🤐":       "zipper-mouth face",
+	"😐":       "neutral face",
+	"😑":       "expressionless face",
+	"😶":       "face without mouth",
+	"😏":       "smirking face",
+	"😒":       "unamused face",
+	"🙄":       "face with rolling eyes",
+	"😬":       "grimacing face",
+	"😌":       "relieved face",
+	"😔":       "pensive face",
+	"😪":       "sleepy face",
+	"😴":       "sleeping face",
+	"😷":       "face with medical mask",
+	"🤒":       "face with thermometer",
+	"🤢":       "nauseated face",
+	"🤮":       "face vomiting",
+	"🥵":       "hot face",
+	"🥶":       "cold face",
+	"😵":       "face with crossed-out eyes",
+	"🤯":       "exploding head",
+	"🤠":       "cowboy hat face",
+	"🥳":       "partying face",
+	"😎":       "smiling face with sunglasses",
+	"🤓":       "nerd face",
+	"😕":       "confused face",
+	"😟":       "worried face",
+	"🙁":       "slightly frowning face",
+	"😮":       "face with open mouth",
+	"😲":       "astonished face",
+	"😳":       "flushed face",
+	"🥺":       "pleading face",
+	"😢":       "crying face",
+	"😭":       "loudly crying face",
+	"😱":       "face screaming in fear",
+	"😡":       "enraged face",
+	"😠":       "angry face",
+	"🤬":       "face with symbols on mouth",
+	"😈":       "smiling face with horns",
+	"💀":       "skull",
+	"☠":       "skull and crossbones",
+	"💩":       "pile of poo",
+	"🤡":       "clown face",
+	"👻":       "ghost",
+	"👽":       "alien",
+	"🤖":       "robot",
+	"😺":       "grinning cat",
+	"❤":       "red heart",
+	"🧡":       "orange heart",
+	"💛":       "yellow heart",
+	"💚":       "green heart",
+	"💙":       "blue heart",
+	"💜":       "purple heart",
+	"🖤":       "black heart",
+	"🤍":       "white heart",
+	"💔":       "broken heart",
+	"💕":       "two hearts",
+	"💖":       "sparkling heart",
+	"💯":       "hundred points",
+	"💥":       "collision",
+	"💫":       "dizzy",
+	"💦":       "sweat droplets",
+	"💤":       "zzz",
+	"👋":       "waving hand",
+	"✋":       "raised hand",
+	"👌":       "OK hand",
+	"✌":       "victory hand",
+	"🤞":       "crossed fingers",
+	"👈":       "backhand index pointing left",
+	"👉":       "backhand index pointing right",
+	"👆":       "backhand index pointing up",
+	"👇":       "backhand index pointing down",
+	"☝":       "index pointing up",
+	"👍":       "thumbs up",
+	"👎":       "thumbs down",
+	"✊":       "raised fist",
+	"👊":       "oncoming fist",
+	"👏":       "clapping hands",
+	"🙌":       "raising hands",
+	"🙏":       "folded hands",
+	"💪":       "flexed biceps",
+	"👀":       "eyes",
+	"🧠":       "brain",
+	"👶":       "baby",
+	"👦":       "boy",
+	"👧":       "girl",
+	"🧑":       "person",
+	"👨":       "man",
+	"👩":       "woman",
+	"👴":       "old man",
+	"👵":       "old woman",
+	"🙋":       "person raising hand",
+	"🤷":       "person shrugging",
+	"🤦":       "person facepalming",
+	"🏃":       "person running",
+	"🚶":       "person walking",
+	"💃":       "woman dancing",
+	"👪":       "family",
+	"🐶":       "dog face",
+	"🐱":       "cat face",
+	"🐭":       "mouse face",
+	"🐰":       "rabbit face",
+	"🦊":       "fox",
+	"🐻":       "bear",
+	"🐼":       "panda",
+	"🐨":       "koala",
+	"🐯":       "tiger face",
+	"🦁":       "lion",
+	"🐮":       "cow face",
+	"🐷":       "pig face",
+	"🐸":       "frog",
+	"🐵":       "monkey face",
+	"🐔":       "chicken",
+	"🐧":       "penguin",
+	"🐦":       "bird",
+	"🦄":       "unicorn",
+	"🐝":       "honeybee",
+	"🦋":       "butterfly",
+	"🐢":       "turtle",
+	"🐍":       "snake",
+	"🐙":       "octopus",
+	"🐟":       "fish",
+	"🐬":       "dolphin",
+	"🐳":       "spouting whale",
+	"🦈":       "shark",
+	"🐘":       "elephant",
+	"🐎":       "horse",
+	"🐕":       "dog",
+	"🐈":       "cat",
+	"🌸":       "cherry blossom",
+	"🌹":       "rose",
+	"🌻":       "sunflower",
+	"🌷":       "tulip",
+	"🌱":       "seedling",
+	"🌲":       "evergreen tree",
+	"🌳":       "deciduous tree",
+	"🌴":       "palm tree",
+	"🌵":       "cactus",
+	"🍀":       "four leaf clover",
+	"🍁":       "maple leaf",
+	"🍂":       "fallen leaf",
+	"☀":       "sun",
+	"🌞":       "sun with face",
+	"🌙":       "crescent moon",
+	"⭐":       "star",
+	"🌟":       "glowing star",
+	"✨":       "sparkles",
+	"⚡":       "high voltage",
+	"🔥":       "fire",
+	"🌈":       "rainbow",
+	"☁":       "cloud",
+	"⛅":       "sun behind cloud",
+	"🌧":       "cloud with rain",
+	"⛈":       "cloud with lightning and rain",
+	"❄":       "snowflake",
+	"☃":       "snowman",
+	"⛄":       "snowman without snow",
+	"🌊":       "water wave",
+	"💧":       "droplet",
+	"🌍":       "globe showing Europe-Africa",
+	"🌎":       "globe showing Americas",
+	"🌏":       "globe showing Asia-Australia",
+	"🌐":       "globe with meridians",
+	"🍎":       "red apple",
+	"🍏":       "green apple",
+	"🍐":       "pear",
+	"🍊":       "tangerine",
+	"🍋":       "lemon",
+	"🍌":       "banana",
+	"🍉":       "watermelon",
+	"🍇":       "grapes",
+	"🍓":       "strawberry",
+	"🍒":       "cherries",
+	"🍑":       "peach",
+	"🥭":       "mango",
+	"🍍":       "pineapple",
+	"🥥":       "coconut",
+	"🥝":       "kiwi fruit",
+	"🍅":       "tomato",
+	"🥑":       "avocado",
+	"🥕":       "carrot",
+	"🌽":       "ear of corn",
+	"🌶":       "hot pepper",
+	"🥦":       "broccoli",
+	"🍄":       "mushroom",
+	"🥜":       "peanuts",
+	"🍞":       "bread",
+	"🥐":       "croissant",
+	"🥨":       "pretzel",
+	"🧀":       "cheese wedge",
+	"🍖":       "meat on bone",
+	"🍗":       "poultry leg",
+	"🥓":       "bacon",
+	"🍔":       "hamburger",
+	"🍟":       "french fries",
+	"🍕":       "pizza",
+	"🌭":       "hot dog",
+	"🥪":       "sandwich",
+	"🌮":       "taco",
+	"🌯":       "burrito",
+	"🍳":       "cooking",
+	"🥗":       "green salad",
+	"🍿":       "popcorn",
+	"🍱":       "bento box",
+	"🍣":       "sushi",
+	"🍜":       "steaming bowl",
+	"🍝":       "spaghetti",
+	"🍦":       "soft ice cream",
+	"🍩":       "doughnut",
+	"🍪":       "cookie",
+	"🎂":       "birthday cake",
+	"🍰":       "shortcake",
+	"🧁":       "cupcake",
+	"🍫":       "chocolate bar",
+	"🍬":       "candy",
+	"🍭":       "lollipop",
+	"🍯":       "honey pot",
+	"☕":       "hot beverage",
+	"🍵":       "teacup without handle",
+	"🍺":       "beer mug",
+	"🍻":       "clinking beer mugs",
+	"🍷":       "wine glass",
+	"🍸":       "cocktail glass",
+	"🍹":       "tropical drink",
+	"🥂":       "clinking glasses",
+	"🍾":       "bottle with popping cork",
+	"🏖":       "beach with umbrella",
+	"🏝":       "desert island",
+	"🏔":       "snow-capped mountain",
+	"⛰":       "mountain",
+	"🌋":       "volcano",
+	"🏕":       "camping",
+	"🏠":       "house",
+	"🏡":       "house with garden",
+	"🏢":       "office building",
+	"🏥":       "hospital",
+	"🏦":       "bank",
+	"🏨":       "hotel",
+	"🏫":       "school",
+	"🏰":       "castle",
+	"🗼":       "Tokyo tower",
+	"🗽":       "Statue of Liberty",
+	"⛪":       "church",
+	"🎡":       "ferris wheel",
+	"🎢":       "roller coaster",
+	"🚗":       "automobile",
+	"🚕":       "taxi",
+	"🚌":       "bus",
+	"🚲":       "bicycle",
+	"🛴":       "kick scooter",
+	"🏍":       "motorcycle",
+	"🚂":       "locomotive",
+	"🚆":       "train",
+	"🚇":       "metro",
+	"✈":       "airplane",
+	"🚀":       "rocket",
+	"🚁":       "helicopter",
+	"⛵":       "sailboat",
+	"🚢":       "ship",
+	"⚓":       "anchor",
+	"⛽":       "fuel pump",
+	"🚦":       "vertical traffic light",
+	"🗺":       "world map",
+	"🎉":       "party popper",
+	"🎊":       "confetti ball",
+	"🎈":       "balloon",
+	"🎁":       "wrapped gift",
+	"🎄":       "Christmas tree",
+	"🎃":       "jack-o-lantern",
+	"🎆":       "fireworks",
+	"🎇":       "sparkler",
+	"🎀":       "ribbon",
+	"🏆":       "trophy",
+	"🥇":       "1st place medal",
+	"🥈":       "2nd place medal",
+	"🥉":       "3rd place medal",
+	"🏅":       "sports medal",
+	"⚽":       "soccer ball",
+	"⚾":       "baseball",
+	"🏀":       "basketball",
+	"🏈":       "american football",
+	"🎾":       "tennis",
+	"🏐":       "volleyball",
+	"🎱":       "pool 8 ball",
+	"🎮":       "video game",
+	"🎲":       "game die",
+	"🎯":       "bullseye",
+	"🎨":       "artist palette",
+	"🎬":       "clapper board",
+	"🎤":       "microphone",
+	"🎧":       "headphone",
+	"🎵":       "musical note",
+	"🎶":       "musical notes",
+	"🎸":       "guitar",
+	"🎹":       "musical keyboard",
+	"🎺":       "trumpet",
+	"🎻":       "violin",
+	"🥁":       "drum",
+	"📱":       "mobile phone",
+	"💻":       "laptop",
+	"🖥":       "desktop computer",
+	"⌨":       "keyboard",
+	"🖱":       "computer mouse",
+	"📷":       "camera",
+	"📸":       "camera with flash",
+	"📺":       "television",
+	"📻":       "radio",
+	"⏰":       "alarm clock",
+	"⌚":       "watch",
+	"⏳":       "hourglass not done",
+	"⌛":       "hourglass done",
+	"💡":       "light bulb",
+	"🔦":       "flashlight",
+	"🕯":       "candle",
+	"💰":       "money bag",
+	"💵":       "dollar banknote",
+	"💶":       "euro banknote",
+	"💳":       "credit card",
+	"💎":       "gem stone",
+	"🔧":       "wrench",
+	"🔨":       "hammer",
+	"🔩":       "nut and bolt",
+	"⚙":       "gear",
+	"🧲":       "magnet",
+	"🔑":       "key",
+	"🔒":       "locked",
+	"🔓":       "unlocked",
+	"📌":       "pushpin",
+	"📎":       "paperclip",
+	"✂":       "scissors",
+	"📝":       "memo",
+	"✏":       "pencil",
+	"📚":       "books",
+	"📖":       "open book",
+	"📅":       "calendar",
+	"📆":       "tear-off calendar",
+	"📈":       "chart increasing",
+	"📉":       "chart decreasing",
+	"📊":       "bar chart",
+	"📦":       "package",
+	"✉":       "envelope",
+	"📧":       "e-mail",
+	"📞":       "telephone receiver",
+	"🔔":       "bell",
+	"🔕":       "bell with slash",
+	"📣":       "megaphone",
+	"📢":       "loudspeaker",
+	"🔍":       "magnifying glass tilted left",
+	"🔗":       "link",
+	"🛒":       "shopping cart",
+	"🛍":       "shopping bags",
+	"🎓":       "graduation cap",
+	"👑":       "crown",
+	"👓":       "glasses",
+	"👕":       "t-shirt",
+	"👖":       "jeans",
+	"👗":       "dress",
+	"👟":       "running shoe",
+	"👠":       "high-heeled shoe",
+	"👜":       "handbag",
+	"🎒":       "backpack",
+	"💍":       "ring",
+	"💄":       "lipstick",
+	"☂":       "umbrella",
+	"☔":       "umbrella with rain drops",
+	"🧳":       "luggage",
+	"✅":       "check mark button",
+	"✔":       "check mark",
+	"❌":       "cross mark",
+	"❎":       "cross mark button",
+	"❓":       "red question mark",
+	"❗":       "red exclamation mark",
+	"⚠":       "warning",
+	"🚫":       "prohibited",
+	"⛔":       "no entry",
+	"♻":       "recycling symbol",
+	"➕":       "plus",
+	"➖":       "minus",
+	"➗":       "divide",
+	"✖":       "multiply",
+	"🆕":       "NEW button",
+	"🆓":       "FREE button",
+	"🆗":       "OK button",
+	"🆒":       "COOL button",
+	"🔝":       "TOP arrow",
+	"🔴":       "red circle",
+	"🟢":       "green circle",
+	"🔵":       "blue circle",
+	"⚪":       "white circle",
+	"⚫":       "black circle",
+	"⬆":       "up arrow",
+	"⬇":       "down arrow",
+	"➡":       "right arrow",
+	"⬅":       "left arrow",
+	"🔄":       "counterclockwise arrows button",
+	"⏩":       "fast-forward button",
+	"🏁":       "chequered flag",
+	"🚩":       "triangular flag",
+	"🏳":       "white flag",
+	"🏴":       "black flag",
+	"☮":       "peace symbol",
+	"☯":       "yin yang",
+	"♀":       "female sign",
+	"♂":       "male sign",
+	"⚧":       "transgender symbol",
+	"⚕":       "medical symbol",
+	"♾":       "infinity",
+	"🔟":       "keycap: 10",
+	"#\u20E3": "keycap: number sign",
+	"*\u20E3": "keycap: asterisk",
+	"🏻":       "light skin tone",
+	"🏼":       "medium-light skin tone",
+	"🏽":       "medium skin tone",
+	"🏾":       "medium-dark skin tone",
+	"🏿":       "dark skin tone",
+	"\U0001F468\u200D\U0001F469\u200D\U0001F467":                 "family: man, woman, girl",
+	"\U0001F468\u200D\U0001F469\u200D\U0001F466":                 "family: man, woman, boy",
+	"\U0001F468\u200D\U0001F469\u200D\U0001F467\u200D\U0001F466": "family: man, woman, girl, boy",
+	"\U0001F9D1\u200D\U0001F4BB":                                 "technologist",
+	"\U0001F468\u200D\U0001F4BB":                                 "man technologist",
+	"\U0001F469\u200D\U0001F4BB":                                 "woman technologist",
+	"\U0001F468\u200D\U0001F373":                                 "man cook",
+	"\U0001F469\u200D\U0001F373":                                 "woman cook",
+	"\U0001F468\u200D\U0001F680":                                 "man astronaut",
+	"\U0001F469\u200D\U0001F680":                                 "woman astronaut",
+	"\U0001F937\u200D\u2642":                                     "man shrugging",
+	"\U0001F937\u200D\u2640":                                     "woman shrugging",
+	"\U0001F3C3\u200D\u2642":                                     "man running",
+	"\U0001F3C3\u200D\u2640":                                     "woman running",
+	"\U0001F3F3\u200D\U0001F308":                                 "rainbow flag",
+	"\U0001F3F3\u200D\u26A7":                                     "transgender flag",
+	"\U0001F3F4\u200D\u2620":                                     "pirate flag",
+	"\u2764\u200D\U0001F525":                                     "heart on fire",
+	"\U0001F43B\u200D\u2744":                                     "polar bear",
+	"\U0001F441\u200D\U0001F5E8":                                 "eye in speech bubble",
+	"\U0001F3F4\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F": "flag: England",
+	"\U0001F3F4\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F": "flag: Scotland",
+	"\U0001F3F4\U000E0067\U000E0062\U000E0077\U000E006C\U000E0073\U000E007F": "flag: Wales",
+}
+
+// deEmoji are German names of common single emoji, English ones are used
+// for the rest, including sequences.
+var deEmoji = map[string]string{
+	"😀":                          "grinsendes Gesicht",
+	"😂":                          "Gesicht mit Freudentränen",
+	"😊":                          "lächelndes Gesicht mit lachenden Augen",
+	"😍":                          "lächelndes Gesicht mit herzförmigen Augen",
+	"😎":                          "lächelndes Gesicht mit Sonnenbrille",
+	"❤":                          "rotes Herz",
+	"💯":                          "100 Punkte",
+	"👋":                          "winkende Hand",
+	"👍":                          "Daumen hoch",
+	"👎":                          "Daumen runter",
+	"👏":                          "klatschende Hände",
+	"🙏":                          "zusammengelegte Handflächen",
+	"💪":                          "angespannter Bizeps",
+	"🐶":                          "Hundegesicht",
+	"🐱":                          "Katzengesicht",
+	"☀":                          "Sonne",
+	"⭐":                          "Stern",
+	"✨":                          "funkelnde Sterne",
+	"⚡":                          "Hochspannung",
+	"🔥":                          "Feuer",
+	"🌈":                          "Regenbogen",
+	"❄":                          "Schneeflocke",
+	"🌍":                          "Globus mit Europa und Afrika",
+	"🍎":                          "roter Apfel",
+	"🍕":                          "Pizza",
+	"🎂":                          "Geburtstagskuchen",
+	"☕":                          "Heißgetränk",
+	"🍺":                          "Bierkrug",
+	"🍻":                          "anstoßende Bierkrüge",
+	"🍷":                          "Weinglas",
+	"🥂":                          "Sektgläser",
+	"🏖":                          "Strand mit Sonnenschirm",
+	"🏠":                          "Haus",
+	"🚗":                          "Auto",
+	"🚀":                          "Rakete",
+	"✈":                          "Flugzeug",
+	"🎉":                          "Konfettibombe",
+	"🎁":                          "Geschenk",
+	"🎄":                          "Weihnachtsbaum",
+	"🏆":                          "Pokal",
+	"⚽":                          "Fußball",
+	"🎶":                          "Musiknoten",
+	"📱":                          "Mobiltelefon",
+	"💡":                          "Glühbirne",
+	"🛒":                          "Einkaufswagen",
+	"✅":                          "grünes Häkchen",
+	"❌":                          "Kreuzzeichen",
+	"⚠":                          "Warnung",
+	"🏻":                          "helle Hautfarbe",
+	"🏼":                          "mittelhelle Hautfarbe",
+	"🏽":                          "mittlere Hautfarbe",
+	"🏾":                          "mitteldunkle Hautfarbe",
+	"🏿":                          "dunkle Hautfarbe",
+	"\U0001F3F3\u200D\U0001F308": "Regenbogenflagge",
+	"\U0001F3F4\u200D\u2620":     "Piratenflagge",
+}
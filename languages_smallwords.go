@@ -0,0 +1,47 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+// Small words kept lower case by Humanize with TitleCase, unless they start
+// or end the title: articles, conjunctions and short prepositions.
+// Languages without small words use their stop words.
+
+var deSmallWords = []string{
+	"am", "an", "auf", "aus", "bei", "das", "dem", "den", "der", "des", "die",
+	"ein", "eine", "einem", "einen", "einer", "eines", "für", "im", "in",
+	"mit", "oder", "und", "vom", "von", "zu", "zum", "zur",
+}
+
+var enSmallWords = []string{
+	"a", "an", "and", "as", "at", "but", "by", "for", "from", "in", "into",
+	"nor", "of", "on", "or", "per", "the", "to", "vs", "via", "with",
+}
+
+var esSmallWords = []string{
+	"a", "al", "con", "de", "del", "e", "el", "en", "la", "las", "los", "o",
+	"para", "por", "u", "un", "una", "unas", "unos", "y",
+}
+
+var frSmallWords = []string{
+	"à", "au", "aux", "d", "de", "des", "du", "en", "et", "l", "la", "le",
+	"les", "ou", "par", "pour", "sur", "un", "une",
+}
+
+var itSmallWords = []string{
+	"a", "al", "alla", "con", "da", "dal", "dalla", "di", "del", "della", "e",
+	"il", "in", "la", "le", "lo", "nel", "nella", "o", "per", "su", "tra",
+	"un", "una",
+}
+
+var nlSmallWords = []string{
+	"aan", "bij", "de", "een", "en", "het", "in", "met", "of", "op", "te",
+	"tot", "van", "voor",
+}
+
+var ptSmallWords = []string{
+	"a", "ao", "as", "com", "da", "das", "de", "do", "dos", "e", "em", "na",
+	"nas", "no", "nos", "o", "os", "ou", "para", "por", "um", "uma",
+}
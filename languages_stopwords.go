@@ -0,0 +1,120 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+// Stop words removed with Config.RemoveStopWords. Words are written in the
+// language alphabet, they are transliterated the same way as slugs before
+// comparing. Negations are left out on purpose, as removing them changes
+// meaning.
+
+var bgStopWords = []string{
+	"а", "в", "във", "да", "до", "е", "за", "и", "или", "към", "на", "но",
+	"от", "по", "при", "с", "са", "със", "че",
+}
+
+var csStopWords = []string{
+	"a", "ale", "do", "i", "je", "jsou", "k", "ke", "na", "nebo", "o", "od",
+	"pro", "při", "s", "se", "u", "v", "ve", "z", "za", "ze", "že",
+}
+
+var deStopWords = []string{
+	"am", "an", "auf", "aus", "bei", "das", "dem", "den", "der", "des", "die",
+	"ein", "eine", "einem", "einen", "einer", "eines", "für", "im", "in",
+	"ist", "mit", "oder", "sind", "über", "und", "unter", "vom", "von", "zu",
+	"zum", "zur",
+}
+
+var enStopWords = []string{
+	"a", "an", "and", "are", "as", "at", "be", "by", "for", "from", "in",
+	"into", "is", "of", "on", "or", "the", "to", "with",
+}
+
+var esStopWords = []string{
+	"a", "al", "con", "de", "del", "e", "el", "en", "es", "la", "las", "los",
+	"o", "para", "por", "sin", "sobre", "son", "u", "un", "una", "unas",
+	"unos", "y",
+}
+
+var fiStopWords = []string{
+	"ja", "kuin", "mutta", "ne", "on", "ovat", "se", "tai", "että",
+}
+
+var frStopWords = []string{
+	"à", "au", "aux", "avec", "d", "dans", "de", "des", "du", "en", "est",
+	"et", "l", "la", "le", "les", "ou", "par", "pour", "sans", "sont", "sur",
+	"un", "une",
+}
+
+var grStopWords = []string{
+	"από", "για", "είναι", "ένα", "η", "και", "με", "μια", "ο", "οι", "σε",
+	"στη", "στην", "στο", "στον", "τα", "την", "της", "το", "τον", "του",
+	"των", "ή",
+}
+
+var huStopWords = []string{
+	"a", "az", "de", "egy", "és", "hogy", "is", "meg", "van", "vagy",
+}
+
+var idStopWords = []string{
+	"adalah", "atau", "dan", "dari", "dengan", "di", "ini", "itu", "ke",
+	"pada", "sebuah", "untuk", "yang",
+}
+
+var itStopWords = []string{
+	"a", "al", "alla", "con", "da", "dei", "del", "della", "delle", "di", "e",
+	"ed", "è", "fra", "gli", "i", "il", "in", "l", "la", "le", "lo", "nel",
+	"nella", "o", "per", "su", "tra", "un", "una", "uno",
+}
+
+var kkStopWords = []string{
+	"бен", "бұл", "да", "де", "және", "мен", "немесе", "ол", "пен", "та",
+	"те", "үшін", "әлде",
+}
+
+var nbStopWords = []string{
+	"av", "de", "den", "det", "ei", "eller", "en", "er", "et", "for", "fra",
+	"i", "med", "og", "om", "på", "som", "til",
+}
+
+var nlStopWords = []string{
+	"aan", "bij", "dat", "de", "die", "een", "en", "het", "in", "is", "met",
+	"naar", "of", "op", "te", "van", "voor", "zijn",
+}
+
+var nnStopWords = []string{
+	"av", "dei", "den", "det", "ei", "ein", "eit", "eller", "er", "for",
+	"frå", "i", "med", "og", "om", "på", "som", "til",
+}
+
+var plStopWords = []string{
+	"a", "albo", "dla", "do", "i", "jest", "lub", "na", "o", "od", "oraz",
+	"po", "przy", "są", "to", "u", "w", "we", "z", "za", "ze",
+}
+
+var ptStopWords = []string{
+	"a", "as", "com", "da", "das", "de", "do", "dos", "e", "é", "em", "na",
+	"nas", "no", "nos", "o", "os", "ou", "para", "por", "um", "uma", "umas",
+	"uns",
+}
+
+var roStopWords = []string{
+	"a", "al", "ale", "cu", "de", "din", "este", "în", "la", "o", "pe",
+	"pentru", "sau", "sunt", "și", "un", "una",
+}
+
+var slStopWords = []string{
+	"ali", "da", "do", "in", "je", "k", "ki", "na", "od", "po", "pri", "s",
+	"so", "v", "z", "za",
+}
+
+var svStopWords = []string{
+	"av", "de", "den", "det", "eller", "en", "ett", "för", "från", "i", "med",
+	"och", "om", "på", "som", "till", "är",
+}
+
+var trStopWords = []string{
+	"bir", "bu", "da", "de", "için", "ile", "ki", "şu", "ve", "veya",
+}
@@ -6,36 +6,30 @@
 package slug
 
 func init() {
-	// Merge language subs with the default one.
-	// TODO: Find better way so all langs are merged automatically and better
-	// tested.
-	for _, sub := range []*map[rune]string{
-		&bgSub,
-		&csSub,
-		&deSub,
-		&enSub,
-		&esSub,
-		&fiSub,
-		&frSub,
-		&grSub,
-		&huSub,
-		&idSub,
-		&itSub,
-		&kkSub,
-		&nbSub,
-		&nlSub,
-		&nnSub,
-		&plSub,
-		&ptSub,
-		&roSub,
-		&slSub,
-		&svSub,
-		&trSub,
-	} {
-		for key, value := range defaultSub {
-			(*sub)[key] = value
-		}
-	}
+	// Catch ISO 3166-1, ISO 639-1:2002 and ISO 639-3:2007.
+	RegisterLanguage([]string{"bg", "bgr"}, bgSub, WithStopWords(bgStopWords...), WithSymbols(bgSymbols))
+	RegisterLanguage([]string{"cs", "ces"}, csSub, WithStopWords(csStopWords...), WithSymbols(csSymbols))
+	RegisterLanguage([]string{"de", "deu"}, deSub, WithStopWords(deStopWords...), WithSmallWords(deSmallWords...), WithSymbols(deSymbols), WithEmojiNames(deEmoji))
+	RegisterLanguage([]string{"en", "eng"}, enSub, WithStopWords(enStopWords...), WithSmallWords(enSmallWords...))
+	RegisterLanguage([]string{"es", "spa"}, esSub, WithStopWords(esStopWords...), WithSmallWords(esSmallWords...), WithSymbols(esSymbols))
+	RegisterLanguage([]string{"fi", "fin"}, fiSub, WithStopWords(fiStopWords...), WithSymbols(fiSymbols))
+	RegisterLanguage([]string{"fr", "fra"}, frSub, WithStopWords(frStopWords...), WithSmallWords(frSmallWords...), WithSymbols(frSymbols))
+	RegisterLanguage([]string{"gr", "el", "ell"}, grSub, WithStopWords(grStopWords...), WithSymbols(grSymbols))
+	RegisterLanguage([]string{"hu", "hun"}, huSub, WithStopWords(huStopWords...), WithSymbols(huSymbols))
+	RegisterLanguage([]string{"id", "idn", "ind"}, idSub, WithStopWords(idStopWords...), WithSymbols(idSymbols))
+	RegisterLanguage([]string{"it", "ita"}, itSub, WithStopWords(itStopWords...), WithSmallWords(itSmallWords...), WithSymbols(itSymbols))
+	RegisterLanguage([]string{"kk", "kz", "kaz"}, kkSub, WithStopWords(kkStopWords...), WithSymbols(kkSymbols))
+	RegisterLanguage([]string{"nb", "nob"}, nbSub, WithStopWords(nbStopWords...), WithSymbols(nbSymbols))
+	RegisterLanguage([]string{"nl", "nld"}, nlSub, WithStopWords(nlStopWords...), WithSmallWords(nlSmallWords...), WithSymbols(nlSymbols))
+	// Norwegian Nynorsk has the same rules as Bokmål.
+	RegisterLanguage([]string{"nn", "nno"}, nil, WithParent("nb"), WithStopWords(nnStopWords...))
+	RegisterLanguage([]string{"pl", "pol"}, plSub, WithStopWords(plStopWords...), WithSymbols(plSymbols))
+	RegisterLanguage([]string{"pt", "prt", "por"}, ptSub, WithStopWords(ptStopWords...), WithSmallWords(ptSmallWords...), WithSymbols(ptSymbols))
+	RegisterLanguage([]string{"pt-br", "br", "bra"}, nil, WithParent("pt"))
+	RegisterLanguage([]string{"ro", "rou"}, roSub, WithStopWords(roStopWords...), WithSymbols(roSymbols))
+	RegisterLanguage([]string{"sl", "slv"}, slSub, WithStopWords(slStopWords...), WithSymbols(slSymbols))
+	RegisterLanguage([]string{"sv", "swe"}, svSub, WithStopWords(svStopWords...), WithSymbols(svSymbols))
+	RegisterLanguage([]string{"tr", "tur"}, trSub, WithStopWords(trStopWords...), WithSymbols(trSymbols))
 }
 
 var defaultSub = map[rune]string{
@@ -175,9 +169,6 @@ var nbSub = map[rune]string{
 	'Å': "Aa",
 }
 
-// Norwegian Nynorsk has the same rules
-var nnSub = nbSub
-
 var nlSub = map[rune]string{
 	'&': "en",
 	'@': "at",
@@ -0,0 +1,662 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+// Symbol vocabularies below are used with Config.Symbols. Words are
+// written as in the text, before language substitutions and
+// transliteration.
+
+// defaultSymbols are English words, also used for symbols missing in other
+// languages.
+var defaultSymbols = map[string]string{
+	"%":   "percent",
+	"‰":   "per mille",
+	"+":   "plus",
+	"=":   "equals",
+	"°":   "degrees",
+	"°C":  "degrees Celsius",
+	"°F":  "degrees Fahrenheit",
+	"€":   "euro",
+	"$":   "dollar",
+	"£":   "pound",
+	"¥":   "yen",
+	"₹":   "rupee",
+	"₽":   "ruble",
+	"₺":   "lira",
+	"₩":   "won",
+	"₿":   "bitcoin",
+	"¢":   "cent",
+	"©":   "copyright",
+	"®":   "registered",
+	"™":   "trademark",
+	"½":   "one half",
+	"¼":   "one quarter",
+	"¾":   "three quarters",
+	"⅓":   "one third",
+	"⅔":   "two thirds",
+	"EUR": "euro",
+	"USD": "US dollar",
+	"GBP": "pound sterling",
+	"JPY": "yen",
+	"CHF": "Swiss franc",
+	"CNY": "yuan",
+	"INR": "rupee",
+	"RUB": "ruble",
+	"PLN": "zloty",
+	"CZK": "koruna",
+	"SEK": "krona",
+	"NOK": "krone",
+	"DKK": "krone",
+	"HUF": "forint",
+	"TRY": "lira",
+	"BGN": "lev",
+	"RON": "leu",
+	"KZT": "tenge",
+	"BRL": "real",
+	"CAD": "Canadian dollar",
+	"AUD": "Australian dollar",
+}
+
+var bgSymbols = map[string]string{
+	"%":   "процента",
+	"‰":   "промила",
+	"+":   "плюс",
+	"=":   "равно",
+	"°":   "градуса",
+	"°C":  "градуса по Целзий",
+	"°F":  "градуса по Фаренхайт",
+	"€":   "евро",
+	"$":   "долар",
+	"£":   "паунд",
+	"¥":   "йена",
+	"₹":   "рупия",
+	"₽":   "рубла",
+	"₺":   "лира",
+	"₩":   "вон",
+	"₿":   "биткойн",
+	"¢":   "цент",
+	"©":   "авторско право",
+	"®":   "регистрирана марка",
+	"™":   "търговска марка",
+	"½":   "половина",
+	"¼":   "една четвърт",
+	"¾":   "три четвърти",
+	"⅓":   "една трета",
+	"⅔":   "две трети",
+	"EUR": "евро",
+	"USD": "щатски долар",
+	"GBP": "британски паунд",
+	"BGN": "лев",
+}
+
+var csSymbols = map[string]string{
+	"%":   "procent",
+	"‰":   "promile",
+	"+":   "plus",
+	"=":   "rovná se",
+	"°":   "stupňů",
+	"°C":  "stupňů Celsia",
+	"°F":  "stupňů Fahrenheita",
+	"€":   "euro",
+	"$":   "dolar",
+	"£":   "libra",
+	"¥":   "jen",
+	"₹":   "rupie",
+	"₽":   "rubl",
+	"₺":   "lira",
+	"₩":   "won",
+	"₿":   "bitcoin",
+	"¢":   "cent",
+	"©":   "copyright",
+	"®":   "registrovaná ochranná známka",
+	"™":   "ochranná známka",
+	"½":   "polovina",
+	"¼":   "čtvrtina",
+	"¾":   "tři čtvrtiny",
+	"⅓":   "třetina",
+	"⅔":   "dvě třetiny",
+	"USD": "americký dolar",
+	"GBP": "libra šterlinků",
+	"CZK": "koruna",
+}
+
+var deSymbols = map[string]string{
+	"%":   "Prozent",
+	"‰":   "Promille",
+	"+":   "plus",
+	"=":   "gleich",
+	"°":   "Grad",
+	"°C":  "Grad Celsius",
+	"°F":  "Grad Fahrenheit",
+	"€":   "Euro",
+	"$":   "Dollar",
+	"£":   "Pfund",
+	"¥":   "Yen",
+	"₹":   "Rupie",
+	"₽":   "Rubel",
+	"₺":   "Lira",
+	"₩":   "Won",
+	"₿":   "Bitcoin",
+	"¢":   "Cent",
+	"©":   "Copyright",
+	"®":   "eingetragene Marke",
+	"™":   "Marke",
+	"½":   "ein halb",
+	"¼":   "ein Viertel",
+	"¾":   "drei Viertel",
+	"⅓":   "ein Drittel",
+	"⅔":   "zwei Drittel",
+	"USD": "US-Dollar",
+	"GBP": "Pfund Sterling",
+	"CHF": "Franken",
+	"CZK": "Krone",
+	"SEK": "Krone",
+	"NOK": "Krone",
+	"DKK": "Krone",
+	"PLN": "Zloty",
+}
+
+var esSymbols = map[string]string{
+	"%":   "por ciento",
+	"‰":   "por mil",
+	"+":   "más",
+	"=":   "igual",
+	"°":   "grados",
+	"°C":  "grados Celsius",
+	"°F":  "grados Fahrenheit",
+	"€":   "euro",
+	"$":   "dólar",
+	"£":   "libra",
+	"¥":   "yen",
+	"₹":   "rupia",
+	"₽":   "rublo",
+	"₺":   "lira",
+	"₩":   "won",
+	"₿":   "bitcoin",
+	"¢":   "centavo",
+	"©":   "copyright",
+	"®":   "marca registrada",
+	"™":   "marca",
+	"½":   "un medio",
+	"¼":   "un cuarto",
+	"¾":   "tres cuartos",
+	"⅓":   "un tercio",
+	"⅔":   "dos tercios",
+	"USD": "dólar estadounidense",
+	"GBP": "libra esterlina",
+	"CHF": "franco suizo",
+}
+
+var fiSymbols = map[string]string{
+	"%":   "prosenttia",
+	"‰":   "promillea",
+	"+":   "plus",
+	"=":   "on yhtä kuin",
+	"°":   "astetta",
+	"°C":  "astetta Celsiusta",
+	"°F":  "astetta Fahrenheitia",
+	"€":   "euro",
+	"$":   "dollari",
+	"£":   "punta",
+	"¥":   "jeni",
+	"₹":   "rupia",
+	"₽":   "rupla",
+	"₺":   "liira",
+	"₩":   "won",
+	"₿":   "bitcoin",
+	"¢":   "sentti",
+	"©":   "copyright",
+	"®":   "rekisteröity tavaramerkki",
+	"™":   "tavaramerkki",
+	"½":   "puoli",
+	"¼":   "neljännes",
+	"¾":   "kolme neljännestä",
+	"⅓":   "kolmasosa",
+	"⅔":   "kaksi kolmasosaa",
+	"USD": "Yhdysvaltain dollari",
+	"GBP": "Englannin punta",
+	"SEK": "kruunu",
+}
+
+var frSymbols = map[string]string{
+	"%":   "pour cent",
+	"‰":   "pour mille",
+	"+":   "plus",
+	"=":   "égal",
+	"°":   "degrés",
+	"°C":  "degrés Celsius",
+	"°F":  "degrés Fahrenheit",
+	"€":   "euro",
+	"$":   "dollar",
+	"£":   "livre",
+	"¥":   "yen",
+	"₹":   "roupie",
+	"₽":   "rouble",
+	"₺":   "livre turque",
+	"₩":   "won",
+	"₿":   "bitcoin",
+	"¢":   "centime",
+	"©":   "copyright",
+	"®":   "marque déposée",
+	"™":   "marque",
+	"½":   "un demi",
+	"¼":   "un quart",
+	"¾":   "trois quarts",
+	"⅓":   "un tiers",
+	"⅔":   "deux tiers",
+	"USD": "dollar américain",
+	"GBP": "livre sterling",
+	"CHF": "franc suisse",
+	"CAD": "dollar canadien",
+}
+
+var grSymbols = map[string]string{
+	"%":   "τοις εκατό",
+	"‰":   "τοις χιλίοις",
+	"+":   "συν",
+	"=":   "ίσον",
+	"°":   "βαθμοί",
+	"°C":  "βαθμοί Κελσίου",
+	"°F":  "βαθμοί Φαρενάιτ",
+	"€":   "ευρώ",
+	"$":   "δολάριο",
+	"£":   "λίρα",
+	"¥":   "γιεν",
+	"₹":   "ρουπία",
+	"₽":   "ρούβλι",
+	"₺":   "λίρα",
+	"₩":   "γουόν",
+	"₿":   "μπιτκόιν",
+	"¢":   "σεντ",
+	"©":   "πνευματικά δικαιώματα",
+	"®":   "σήμα κατατεθέν",
+	"™":   "εμπορικό σήμα",
+	"½":   "ένα δεύτερο",
+	"¼":   "ένα τέταρτο",
+	"¾":   "τρία τέταρτα",
+	"⅓":   "ένα τρίτο",
+	"⅔":   "δύο τρίτα",
+	"USD": "δολάριο ΗΠΑ",
+	"GBP": "λίρα στερλίνα",
+}
+
+var huSymbols = map[string]string{
+	"%":   "százalék",
+	"‰":   "ezrelék",
+	"+":   "plusz",
+	"=":   "egyenlő",
+	"°":   "fok",
+	"°C":  "Celsius-fok",
+	"°F":  "Fahrenheit-fok",
+	"€":   "euró",
+	"$":   "dollár",
+	"£":   "font",
+	"¥":   "jen",
+	"₹":   "rúpia",
+	"₽":   "rubel",
+	"₺":   "líra",
+	"₩":   "von",
+	"₿":   "bitcoin",
+	"¢":   "cent",
+	"©":   "copyright",
+	"®":   "bejegyzett védjegy",
+	"™":   "védjegy",
+	"½":   "fél",
+	"¼":   "negyed",
+	"¾":   "háromnegyed",
+	"⅓":   "egyharmad",
+	"⅔":   "kétharmad",
+	"USD": "amerikai dollár",
+	"GBP": "angol font",
+	"HUF": "forint",
+}
+
+var idSymbols = map[string]string{
+	"%":   "persen",
+	"‰":   "per mil",
+	"+":   "tambah",
+	"=":   "sama dengan",
+	"°":   "derajat",
+	"°C":  "derajat Celsius",
+	"°F":  "derajat Fahrenheit",
+	"€":   "euro",
+	"$":   "dolar",
+	"£":   "pound",
+	"¥":   "yen",
+	"₹":   "rupee",
+	"₽":   "rubel",
+	"₺":   "lira",
+	"₩":   "won",
+	"₿":   "bitcoin",
+	"¢":   "sen",
+	"©":   "hak cipta",
+	"®":   "merek terdaftar",
+	"™":   "merek dagang",
+	"½":   "setengah",
+	"¼":   "seperempat",
+	"¾":   "tiga perempat",
+	"⅓":   "sepertiga",
+	"⅔":   "dua pertiga",
+	"IDR": "rupiah",
+	"USD": "dolar AS",
+	"GBP": "pound sterling",
+}
+
+var itSymbols = map[string]string{
+	"%":   "per cento",
+	"‰":   "per mille",
+	"+":   "più",
+	"=":   "uguale",
+	"°":   "gradi",
+	"°C":  "gradi Celsius",
+	"°F":  "gradi Fahrenheit",
+	"€":   "euro",
+	"$":   "dollaro",
+	"£":   "sterlina",
+	"¥":   "yen",
+	"₹":   "rupia",
+	"₽":   "rublo",
+	"₺":   "lira",
+	"₩":   "won",
+	"₿":   "bitcoin",
+	"¢":   "centesimo",
+	"©":   "copyright",
+	"®":   "marchio registrato",
+	"™":   "marchio",
+	"½":   "un mezzo",
+	"¼":   "un quarto",
+	"¾":   "tre quarti",
+	"⅓":   "un terzo",
+	"⅔":   "due terzi",
+	"USD": "dollaro statunitense",
+	"GBP": "sterlina",
+	"CHF": "franco svizzero",
+}
+
+var kkSymbols = map[string]string{
+	"%":   "пайыз",
+	"‰":   "промилле",
+	"+":   "қосу",
+	"=":   "тең",
+	"°":   "градус",
+	"°C":  "Цельсий градусы",
+	"°F":  "Фаренгейт градусы",
+	"€":   "еуро",
+	"$":   "доллар",
+	"£":   "фунт",
+	"¥":   "иена",
+	"₹":   "рупия",
+	"₽":   "рубль",
+	"₺":   "лира",
+	"₩":   "вон",
+	"₸":   "теңге",
+	"₿":   "биткоин",
+	"¢":   "цент",
+	"©":   "авторлық құқық",
+	"®":   "тіркелген тауар белгісі",
+	"™":   "тауар белгісі",
+	"½":   "жарты",
+	"¼":   "ширек",
+	"¾":   "төрттен үш",
+	"⅓":   "үштен бір",
+	"⅔":   "үштен екі",
+	"KZT": "теңге",
+	"USD": "АҚШ доллары",
+	"RUB": "рубль",
+}
+
+var nbSymbols = map[string]string{
+	"%":   "prosent",
+	"‰":   "promille",
+	"+":   "pluss",
+	"=":   "er lik",
+	"°":   "grader",
+	"°C":  "grader celsius",
+	"°F":  "grader fahrenheit",
+	"€":   "euro",
+	"$":   "dollar",
+	"£":   "pund",
+	"¥":   "yen",
+	"₹":   "rupi",
+	"₽":   "rubel",
+	"₺":   "lira",
+	"₩":   "won",
+	"₿":   "bitcoin",
+	"¢":   "cent",
+	"©":   "copyright",
+	"®":   "registrert varemerke",
+	"™":   "varemerke",
+	"½":   "en halv",
+	"¼":   "en kvart",
+	"¾":   "tre kvart",
+	"⅓":   "en tredjedel",
+	"⅔":   "to tredjedeler",
+	"USD": "amerikanske dollar",
+	"GBP": "britiske pund",
+	"NOK": "krone",
+	"SEK": "svenske kroner",
+	"DKK": "danske kroner",
+}
+
+var nlSymbols = map[string]string{
+	"%":   "procent",
+	"‰":   "promille",
+	"+":   "plus",
+	"=":   "is gelijk aan",
+	"°":   "graden",
+	"°C":  "graden Celsius",
+	"°F":  "graden Fahrenheit",
+	"€":   "euro",
+	"$":   "dollar",
+	"£":   "pond",
+	"¥":   "yen",
+	"₹":   "roepie",
+	"₽":   "roebel",
+	"₺":   "lira",
+	"₩":   "won",
+	"₿":   "bitcoin",
+	"¢":   "cent",
+	"©":   "copyright",
+	"®":   "gedeponeerd",
+	"™":   "handelsmerk",
+	"½":   "een half",
+	"¼":   "een kwart",
+	"¾":   "driekwart",
+	"⅓":   "een derde",
+	"⅔":   "twee derde",
+	"USD": "Amerikaanse dollar",
+	"GBP": "Brits pond",
+	"CHF": "Zwitserse frank",
+}
+
+var plSymbols = map[string]string{
+	"%":   "procent",
+	"‰":   "promil",
+	"+":   "plus",
+	"=":   "równa się",
+	"°":   "stopni",
+	"°C":  "stopni Celsjusza",
+	"°F":  "stopni Fahrenheita",
+	"€":   "euro",
+	"$":   "dolar",
+	"£":   "funt",
+	"¥":   "jen",
+	"₹":   "rupia",
+	"₽":   "rubel",
+	"₺":   "lira",
+	"₩":   "won",
+	"₿":   "bitcoin",
+	"¢":   "cent",
+	"©":   "copyright",
+	"®":   "zastrzeżony znak towarowy",
+	"™":   "znak towarowy",
+	"½":   "pół",
+	"¼":   "ćwierć",
+	"¾":   "trzy czwarte",
+	"⅓":   "jedna trzecia",
+	"⅔":   "dwie trzecie",
+	"USD": "dolar amerykański",
+	"GBP": "funt szterling",
+	"PLN": "złoty",
+}
+
+var ptSymbols = map[string]string{
+	"%":   "por cento",
+	"‰":   "por mil",
+	"+":   "mais",
+	"=":   "igual",
+	"°":   "graus",
+	"°C":  "graus Celsius",
+	"°F":  "graus Fahrenheit",
+	"€":   "euro",
+	"$":   "dólar",
+	"£":   "libra",
+	"¥":   "iene",
+	"₹":   "rupia",
+	"₽":   "rublo",
+	"₺":   "lira",
+	"₩":   "won",
+	"₿":   "bitcoin",
+	"¢":   "cêntimo",
+	"©":   "copyright",
+	"®":   "marca registada",
+	"™":   "marca",
+	"½":   "um meio",
+	"¼":   "um quarto",
+	"¾":   "três quartos",
+	"⅓":   "um terço",
+	"⅔":   "dois terços",
+	"USD": "dólar americano",
+	"GBP": "libra esterlina",
+	"BRL": "real",
+}
+
+var roSymbols = map[string]string{
+	"%":   "la sută",
+	"‰":   "la mie",
+	"+":   "plus",
+	"=":   "egal",
+	"°":   "grade",
+	"°C":  "grade Celsius",
+	"°F":  "grade Fahrenheit",
+	"€":   "euro",
+	"$":   "dolar",
+	"£":   "liră",
+	"¥":   "yen",
+	"₹":   "rupie",
+	"₽":   "rublă",
+	"₺":   "liră",
+	"₩":   "won",
+	"₿":   "bitcoin",
+	"¢":   "cent",
+	"©":   "copyright",
+	"®":   "marcă înregistrată",
+	"™":   "marcă comercială",
+	"½":   "o jumătate",
+	"¼":   "un sfert",
+	"¾":   "trei sferturi",
+	"⅓":   "o treime",
+	"⅔":   "două treimi",
+	"USD": "dolar american",
+	"GBP": "liră sterlină",
+	"RON": "leu",
+}
+
+var slSymbols = map[string]string{
+	"%":   "odstotkov",
+	"‰":   "promil",
+	"+":   "plus",
+	"=":   "je enako",
+	"°":   "stopinj",
+	"°C":  "stopinj Celzija",
+	"°F":  "stopinj Fahrenheita",
+	"€":   "evro",
+	"$":   "dolar",
+	"£":   "funt",
+	"¥":   "jen",
+	"₹":   "rupija",
+	"₽":   "rubelj",
+	"₺":   "lira",
+	"₩":   "von",
+	"₿":   "bitcoin",
+	"¢":   "cent",
+	"©":   "avtorske pravice",
+	"®":   "registrirana blagovna znamka",
+	"™":   "blagovna znamka",
+	"½":   "ena polovica",
+	"¼":   "ena četrtina",
+	"¾":   "tri četrtine",
+	"⅓":   "ena tretjina",
+	"⅔":   "dve tretjini",
+	"EUR": "evro",
+	"USD": "ameriški dolar",
+	"GBP": "britanski funt",
+}
+
+var svSymbols = map[string]string{
+	"%":   "procent",
+	"‰":   "promille",
+	"+":   "plus",
+	"=":   "lika med",
+	"°":   "grader",
+	"°C":  "grader Celsius",
+	"°F":  "grader Fahrenheit",
+	"€":   "euro",
+	"$":   "dollar",
+	"£":   "pund",
+	"¥":   "yen",
+	"₹":   "rupie",
+	"₽":   "rubel",
+	"₺":   "lira",
+	"₩":   "won",
+	"₿":   "bitcoin",
+	"¢":   "cent",
+	"©":   "copyright",
+	"®":   "registrerat varumärke",
+	"™":   "varumärke",
+	"½":   "en halv",
+	"¼":   "en fjärdedel",
+	"¾":   "tre fjärdedelar",
+	"⅓":   "en tredjedel",
+	"⅔":   "två tredjedelar",
+	"USD": "amerikansk dollar",
+	"GBP": "brittiskt pund",
+	"SEK": "krona",
+	"NOK": "norsk krona",
+	"DKK": "dansk krona",
+}
+
+var trSymbols = map[string]string{
+	"%":   "yüzde",
+	"‰":   "binde",
+	"+":   "artı",
+	"=":   "eşittir",
+	"°":   "derece",
+	"°C":  "derece Celsius",
+	"°F":  "derece Fahrenheit",
+	"€":   "avro",
+	"$":   "dolar",
+	"£":   "sterlin",
+	"¥":   "yen",
+	"₹":   "rupi",
+	"₽":   "ruble",
+	"₺":   "lira",
+	"₩":   "won",
+	"₿":   "bitcoin",
+	"¢":   "sent",
+	"©":   "telif hakkı",
+	"®":   "tescilli marka",
+	"™":   "ticari marka",
+	"½":   "yarım",
+	"¼":   "çeyrek",
+	"¾":   "üç çeyrek",
+	"⅓":   "üçte bir",
+	"⅔":   "üçte iki",
+	"EUR": "avro",
+	"USD": "ABD doları",
+	"GBP": "İngiliz sterlini",
+	"TRY": "Türk lirası",
+}
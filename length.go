@@ -0,0 +1,187 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/width"
+)
+
+// LengthUnit defines how MaxLength is measured.
+type LengthUnit int
+
+const (
+	// LengthBytes measures length in bytes of UTF-8 encoded slug.
+	LengthBytes LengthUnit = iota
+	// LengthRunes measures length in Unicode code points.
+	LengthRunes
+	// LengthGraphemes measures length in user-perceived characters
+	// (grapheme clusters), e.g. "é" written with combining accent or "👍🏽"
+	// count as one.
+	LengthGraphemes
+	// LengthWidth measures length in terminal columns: East Asian wide
+	// characters and emoji take two columns, combining marks none.
+	LengthWidth
+)
+
+// textLength returns length of the text in provided unit.
+func textLength(text string, unit LengthUnit) int {
+	switch unit {
+	case LengthRunes:
+		return utf8.RuneCountInString(text)
+	case LengthGraphemes, LengthWidth:
+		n := 0
+		for i := 0; i < len(text); {
+			size := graphemeLen(text[i:])
+			n += graphemeSize(text[i:i+size], unit)
+			i += size
+		}
+		return n
+	}
+	return len(text)
+}
+
+// truncateLength returns the longest prefix of the text not longer than
+// maxLength in provided unit, never cutting a grapheme cluster.
+func truncateLength(text string, maxLength int, unit LengthUnit) string {
+	if maxLength <= 0 {
+		return ""
+	}
+	if unit == LengthBytes && len(text) <= maxLength {
+		return text
+	}
+	// ASCII text cut before an ASCII char can't break a cluster.
+	if unit == LengthBytes && text[maxLength] < utf8.RuneSelf && text[maxLength-1] < utf8.RuneSelf {
+		return text[:maxLength]
+	}
+
+	n := 0
+	for i := 0; i < len(text); {
+		size := graphemeLen(text[i:])
+		n += graphemeSize(text[i:i+size], unit)
+		if n > maxLength {
+			return text[:i]
+		}
+		i += size
+	}
+	return text
+}
+
+// graphemeSize returns length of one grapheme cluster in provided unit.
+func graphemeSize(cluster string, unit LengthUnit) int {
+	switch unit {
+	case LengthRunes:
+		return utf8.RuneCountInString(cluster)
+	case LengthGraphemes:
+		return 1
+	case LengthWidth:
+		r, _ := utf8.DecodeRuneInString(cluster)
+		switch {
+		case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+			return 0
+		case isEmoji(r) || strings.ContainsRune(cluster, variationEmoji):
+			return 2
+		}
+		switch width.LookupRune(r).Kind() {
+		case width.EastAsianWide, width.EastAsianFullwidth:
+			return 2
+		}
+		return 1
+	}
+	return len(cluster)
+}
+
+// graphemeLen returns length in bytes of the grapheme cluster at the
+// beginning of the text. It follows the most important rules of Unicode
+// extended grapheme clusters: combining marks and other extending
+// characters, zero width joiner sequences, regional indicator pairs
+// (flags), Hangul syllables and CR LF.
+func graphemeLen(text string) int {
+	r, n := utf8.DecodeRuneInString(text)
+	switch {
+	case r == '\r':
+		if n < len(text) && text[n] == '\n' {
+			n++
+		}
+		return n
+	case r < utf8.RuneSelf && (n == len(text) || text[n] < utf8.RuneSelf):
+		// ASCII fast path.
+		return n
+	case isRegionalIndicator(r):
+		if r2, size := utf8.DecodeRuneInString(text[n:]); isRegionalIndicator(r2) {
+			n += size
+		}
+		r = 0
+	}
+
+	prev := r
+	for n < len(text) {
+		next, size := utf8.DecodeRuneInString(text[n:])
+		switch {
+		case isGraphemeExtend(next):
+		case prev == zeroWidthJoiner && isEmoji(next):
+		case hangulJoins(prev, next):
+		default:
+			return n
+		}
+		prev = next
+		n += size
+	}
+	return n
+}
+
+// isGraphemeExtend reports if the rune never starts a grapheme cluster.
+func isGraphemeExtend(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc) ||
+		r == zeroWidthJoiner || r == '\u200C' ||
+		r >= '\uFE00' && r <= variationEmoji ||
+		isSkinTone(r) || isTag(r)
+}
+
+// Hangul syllable types, see Unicode Standard Annex #29.
+const (
+	hangulNone = iota
+	hangulL
+	hangulV
+	hangulT
+	hangulLV
+	hangulLVT
+)
+
+func hangulType(r rune) int {
+	switch {
+	case r >= 0x1100 && r <= 0x115F, r >= 0xA960 && r <= 0xA97C:
+		return hangulL
+	case r >= 0x1160 && r <= 0x11A7, r >= 0xD7B0 && r <= 0xD7C6:
+		return hangulV
+	case r >= 0x11A8 && r <= 0x11FF, r >= 0xD7CB && r <= 0xD7FB:
+		return hangulT
+	case r >= 0xAC00 && r <= 0xD7A3:
+		if (r-0xAC00)%28 == 0 {
+			return hangulLV
+		}
+		return hangulLVT
+	}
+	return hangulNone
+}
+
+// hangulJoins reports if Hangul jamo or syllables a and b are parts of
+// one syllable.
+func hangulJoins(a, b rune) bool {
+	ta, tb := hangulType(a), hangulType(b)
+	switch ta {
+	case hangulL:
+		return tb == hangulL || tb == hangulV || tb == hangulLV || tb == hangulLVT
+	case hangulLV, hangulV:
+		return tb == hangulV || tb == hangulT
+	case hangulLVT, hangulT:
+		return tb == hangulT
+	}
+	return false
+}
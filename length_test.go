@@ -0,0 +1,106 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"testing"
+)
+
+//=============================================================================
+
+func TestTextLength(t *testing.T) {
+	testCases := []struct {
+		in                             string
+		bytes, runes, graphemes, width int
+	}{
+		{"", 0, 0, 0, 0},
+		{"hello", 5, 5, 5, 5},
+		{"k\u00e4se", 5, 4, 4, 4},
+		{"ka\u0308se", 6, 5, 4, 4},
+		{"東京", 6, 2, 2, 4},
+		{"👍🏽", 8, 2, 1, 2},
+		{"👨‍👩‍👧", 18, 5, 1, 2},
+		{"🇩🇪🇫🇷", 16, 4, 2, 4},
+		{"한국어", 9, 3, 3, 6},
+		{"\u1112\u1161\u11ab", 9, 3, 1, 2},
+		{"नमस्ते", 18, 6, 4, 4},
+		{"a\r\nb", 4, 4, 3, 3},
+	}
+
+	for index, st := range testCases {
+		for unit, want := range []int{st.bytes, st.runes, st.graphemes, st.width} {
+			if got := textLength(st.in, LengthUnit(unit)); got != want {
+				t.Errorf("%d. textLength(%#v, %v) = %v; want %v", index, st.in, unit, got, want)
+			}
+		}
+	}
+}
+
+func TestSluggerLengthUnit(t *testing.T) {
+	testCases := []struct {
+		unit      LengthUnit
+		smart     bool
+		maxLength int
+		in        string
+		want      string
+	}{
+		{LengthBytes, true, 9, "東京 旅行", "東京"},
+		{LengthBytes, false, 8, "東京旅行", "東京"},
+		{LengthBytes, false, 4, "ka\u0308se", "ka\u0308"},
+		{LengthBytes, false, 3, "ka\u0308se", "k"},
+		{LengthRunes, true, 5, "東京 旅行", "東京-旅行"},
+		{LengthRunes, true, 4, "東京 旅行", "東京"},
+		{LengthRunes, false, 3, "東京旅行", "東京旅"},
+		{LengthRunes, false, 2, "ka\u0308se", "k"},
+		{LengthGraphemes, false, 2, "ka\u0308se", "ka\u0308"},
+		{LengthGraphemes, false, 2, "नमस्ते दुनिया", "नम"},
+		{LengthGraphemes, true, 7, "नमस्ते दुनिया", "नमस्ते"},
+		{LengthWidth, false, 5, "東京旅行", "東京"},
+		{LengthWidth, true, 9, "東京 旅行", "東京-旅行"},
+		{LengthWidth, true, 8, "東京 旅行", "東京"},
+		{LengthWidth, true, 11, "hello world", "hello-world"},
+	}
+
+	for index, st := range testCases {
+		c := DefaultConfig()
+		c.Unicode = true
+		c.LengthUnit = st.unit
+		c.EnableSmartTruncate = st.smart
+		c.MaxLength = st.maxLength
+		got := New(c).Make(st.in)
+		if got != st.want {
+			t.Errorf(
+				"%d. LengthUnit = %v, MaxLength = %v; Make(%#v) = %#v; want %#v",
+				index, st.unit, st.maxLength, st.in, got, st.want)
+		}
+	}
+}
+
+func TestSluggerLengthUnitIsSlug(t *testing.T) {
+	testCases := []struct {
+		unit LengthUnit
+		in   string
+		want bool
+	}{
+		{LengthBytes, "東京-旅行", false},
+		{LengthRunes, "東京-旅行", true},
+		{LengthGraphemes, "東京-旅行", true},
+		{LengthWidth, "東京-旅行", false},
+		{LengthWidth, "東京", true},
+		{LengthGraphemes, "नमस्ते", true},
+		{LengthRunes, "नमस्ते", false},
+	}
+
+	for index, st := range testCases {
+		c := DefaultConfig()
+		c.Unicode = true
+		c.LengthUnit = st.unit
+		c.MaxLength = 5
+		if got := New(c).IsSlug(st.in); got != st.want {
+			t.Errorf("%d. LengthUnit = %v; IsSlug(%#v) = %#v; want %#v", index, st.unit, st.in, got, st.want)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"strings"
+)
+
+// MakePath returns path generated from provided string split on delim,
+// e.g. "Guides / Getting Started" with delim "/" gives
+// "guides/getting-started", and the path segments.
+// See Slugger.MakePath.
+func MakePath(s string, delim string) (path string, segments []string) {
+	return globalSlugger().MakePath(s, delim)
+}
+
+// MakePath returns path generated from provided string split on delim,
+// and the path segments. Every segment is made like with Make, so
+// MaxLength limits each of them. Segments without letters or numbers are
+// dropped. Segments are joined with delim without surrounding spaces,
+// default delim is "/".
+// Suffixes like the timestamp are appended only once, to the last segment,
+// and their length is reserved from MaxLength and MaxPathLength; they are
+// dropped when they don't fit. Without any segment the path is just the
+// suffixes, like the slug made by Make. Reserved slugs aren't checked in
+// segments.
+// When the path is longer than MaxPathLength, the first segment which
+// doesn't fit is shortened and the following ones are dropped.
+func (s *Slugger) MakePath(text string, delim string) (path string, segments []string) {
+	if delim == "" {
+		delim = "/"
+	}
+	join := strings.TrimSpace(delim)
+	if join == "" {
+		join = delim
+	}
+
+	c := &s.cfg
+	l := s.language("en")
+	var bases []string
+	for _, part := range strings.Split(text, delim) {
+		if base := s.runStages(part, l); base != "" {
+			bases = append(bases, base)
+		}
+	}
+	if len(bases) == 0 {
+		// Like Make, text without letters and numbers gives just the
+		// suffixes.
+		path = s.fit("", s.suffixes()...)
+		if c.MaxPathLength > 0 && textLength(path, c.LengthUnit) > c.MaxPathLength {
+			path = s.trimEnds(truncateLength(path, c.MaxPathLength, c.LengthUnit))
+		}
+		if path == "" {
+			return "", nil
+		}
+		return path, []string{path}
+	}
+
+	tail := strings.Join(s.suffixes(), s.sep)
+	tailLength := textLength(s.sep+tail, c.LengthUnit)
+	if tail != "" && (c.MaxLength > 0 && tailLength >= c.MaxLength ||
+		c.MaxPathLength > 0 && tailLength >= c.MaxPathLength) {
+		tail = ""
+	}
+	budget := c.MaxPathLength
+	if tail != "" {
+		budget -= tailLength
+	}
+
+	for i, base := range bases {
+		maxLength := c.MaxLength
+		if i == len(bases)-1 && tail != "" && maxLength > 0 {
+			maxLength -= tailLength
+		}
+		segment := s.fitLength(base, maxLength, c.LengthUnit)
+		if segment == "" {
+			continue
+		}
+		if c.MaxPathLength > 0 {
+			if len(segments) > 0 {
+				budget -= textLength(join, c.LengthUnit)
+			}
+			if textLength(segment, c.LengthUnit) > budget {
+				if budget > 0 {
+					if segment = s.fitLength(segment, budget, c.LengthUnit); segment != "" {
+						segments = append(segments, segment)
+					}
+				}
+				break
+			}
+			budget -= textLength(segment, c.LengthUnit)
+		}
+		segments = append(segments, segment)
+	}
+	if tail != "" && len(segments) > 0 {
+		segments[len(segments)-1] += s.sep + tail
+	}
+	return strings.Join(segments, join), segments
+}
@@ -0,0 +1,115 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+//=============================================================================
+
+func TestSlugMakePath(t *testing.T) {
+	testCases := []struct {
+		in       string
+		delim    string
+		want     string
+		segments []string
+	}{
+		{"Guides / Getting Started / Install on Linux", "/", "guides/getting-started/install-on-linux", []string{"guides", "getting-started", "install-on-linux"}},
+		{"Guides/Getting Started", "", "guides/getting-started", []string{"guides", "getting-started"}},
+		{"Home > Zażółć > Gęślą jaźń", " > ", "home>zazolc>gesla-jazn", []string{"home", "zazolc", "gesla-jazn"}},
+		{"Docs :: API", "::", "docs::api", []string{"docs", "api"}},
+		{"/Guides//?/ Install /", "/", "guides/install", []string{"guides", "install"}},
+		{"Fish & Chips", "/", "fish-and-chips", []string{"fish-and-chips"}},
+		{"", "/", "", nil},
+		{" / / ", "/", "", nil},
+	}
+
+	for index, st := range testCases {
+		got, segments := MakePath(st.in, st.delim)
+		if got != st.want || !reflect.DeepEqual(segments, st.segments) {
+			t.Errorf(
+				"%d. MakePath(%#v, %#v) = %#v, %#v; want %#v, %#v",
+				index, st.in, st.delim, got, segments, st.want, st.segments)
+		}
+	}
+}
+
+func TestSluggerMakePathMaxLength(t *testing.T) {
+	in := "Guides / Getting Started / Install on Linux"
+	testCases := []struct {
+		maxLength     int
+		maxPathLength int
+		want          string
+	}{
+		{0, 0, "guides/getting-started/install-on-linux"},
+		{10, 0, "guides/getting/install-on"},
+		{0, 39, "guides/getting-started/install-on-linux"},
+		{0, 38, "guides/getting-started/install-on"},
+		{0, 25, "guides/getting-started/in"},
+		{0, 23, "guides/getting-started"},
+		{0, 12, "guides/getti"},
+		{0, 7, "guides"},
+		{0, 3, "gui"},
+		{10, 20, "guides/getting/insta"},
+	}
+
+	for index, st := range testCases {
+		c := DefaultConfig()
+		c.MaxLength = st.maxLength
+		c.MaxPathLength = st.maxPathLength
+		got, segments := New(c).MakePath(in, "/")
+		if got != st.want {
+			t.Errorf(
+				"%d. MaxLength = %v, MaxPathLength = %v; MakePath(%#v) = %#v; want %#v",
+				index, st.maxLength, st.maxPathLength, in, got, st.want)
+		}
+		for _, segment := range segments {
+			if !IsSlug(segment) {
+				t.Errorf("%d. MakePath(%#v) segment %#v isn't a slug", index, in, segment)
+			}
+		}
+	}
+}
+
+func TestSluggerMakePathTimestamp(t *testing.T) {
+	in := "Guides / Getting Started / Install"
+	testCases := []struct {
+		maxLength     int
+		maxPathLength int
+		want          string
+	}{
+		{0, 0, "guides/getting-started/install-1792271649"},
+		{15, 0, "guides/getting-started/inst-1792271649"},
+		{12, 0, "guides/getting/i-1792271649"},
+		{11, 0, "guides/getting/install"},
+		{0, 40, "guides/getting-started/instal-1792271649"},
+		{0, 30, "guides/getting-1792271649"},
+		{0, 11, "guides/gett"},
+		{0, 4, "guid"},
+	}
+
+	for index, st := range testCases {
+		c := DefaultConfig()
+		c.AppendTimestamp = true
+		c.Clock = ClockFunc(func() time.Time { return time.Unix(1792271649, 0) })
+		c.MaxLength = st.maxLength
+		c.MaxPathLength = st.maxPathLength
+		got, segments := New(c).MakePath(in, "/")
+		if got != st.want {
+			t.Errorf(
+				"%d. MaxLength = %v, MaxPathLength = %v; MakePath(%#v) = %#v; want %#v",
+				index, st.maxLength, st.maxPathLength, in, got, st.want)
+		}
+		for _, segment := range segments {
+			if !IsSlug(segment) {
+				t.Errorf("%d. MakePath(%#v) segment %#v isn't a slug", index, in, segment)
+			}
+		}
+	}
+}
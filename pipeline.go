@@ -0,0 +1,244 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gosimple/unidecode"
+)
+
+// Names of the stages slugs are made with, in the default order. Stages
+// check Config themselves, e.g. StageSymbols does nothing unless
+// Config.Symbols is set. The timestamp is added and the slug is shortened
+// to MaxLength after all stages.
+const (
+	// StageNormalize applies Config.Normalization.
+	StageNormalize = "normalize"
+	// StageTrimSpace removes leading and trailing white space.
+	StageTrimSpace = "trim-space"
+	// StageCustomRuneSub applies Config.CustomRuneSub.
+	StageCustomRuneSub = "custom-rune-sub"
+	// StageCustomSub applies Config.CustomSub.
+	StageCustomSub = "custom-sub"
+	// StageSymbols replaces symbols with words when Config.Symbols is set.
+	StageSymbols = "symbols"
+	// StageEmoji handles emoji as set by Config.Emoji.
+	StageEmoji = "emoji"
+	// StageLanguage applies substitutions of the language.
+	StageLanguage = "language"
+	// StageTransliterate transliterates text to ASCII, unless Config.Unicode
+	// is set.
+	StageTransliterate = "transliterate"
+	// StageLowercase lower cases text when Config.Lowercase is set.
+	StageLowercase = "lowercase"
+	// StageCut cuts text to MaxLength when neither EnableSmartTruncate nor
+	// HashTruncate is set.
+	StageCut = "cut"
+	// StageCleanup replaces unauthorized chars with the separator and
+	// collapses repeated separators.
+	StageCleanup = "cleanup"
+	// StageTrimEnds removes separators and underscores from both ends.
+	StageTrimEnds = "trim-ends"
+	// StageStopWords removes stop words when Config.RemoveStopWords is set.
+	StageStopWords = "stop-words"
+)
+
+// StageFunc transforms text at a stage added with InsertStageBefore,
+// InsertStageAfter or ReplaceStage. Lang is the main code of the language
+// used, e.g. "de".
+type StageFunc func(text string, lang string) string
+
+// StageOp changes the stages of a Slugger, see Config.Stages.
+type StageOp struct {
+	kind   stageOpKind
+	target string
+	stage  stage
+}
+
+type stageOpKind int
+
+const (
+	stageInsertBefore stageOpKind = iota
+	stageInsertAfter
+	stageReplace
+	stageRemove
+)
+
+// InsertStageBefore returns StageOp adding stage called name, running fn,
+// before the stage called target.
+func InsertStageBefore(target, name string, fn StageFunc) StageOp {
+	return StageOp{kind: stageInsertBefore, target: target, stage: customStage(name, fn)}
+}
+
+// InsertStageAfter returns StageOp adding stage called name, running fn,
+// after the stage called target.
+func InsertStageAfter(target, name string, fn StageFunc) StageOp {
+	return StageOp{kind: stageInsertAfter, target: target, stage: customStage(name, fn)}
+}
+
+// ReplaceStage returns StageOp running fn instead of the stage called
+// target. The stage keeps its name.
+func ReplaceStage(target string, fn StageFunc) StageOp {
+	return StageOp{kind: stageReplace, target: target, stage: customStage(target, fn)}
+}
+
+// RemoveStage returns StageOp removing the stage called target.
+func RemoveStage(target string) StageOp {
+	return StageOp{kind: stageRemove, target: target}
+}
+
+// stage is a named step of making a slug.
+type stage struct {
+	name string
+	fn   func(s *Slugger, text string, l *resolvedLanguage) string
+}
+
+func customStage(name string, fn StageFunc) stage {
+	return stage{name: name, fn: func(s *Slugger, text string, l *resolvedLanguage) string {
+		return fn(text, l.code)
+	}}
+}
+
+// defaultStages are stages used when Config.Stages is empty. They are
+// shared by Sluggers and never modified.
+var defaultStages = []stage{
+	{StageNormalize, func(s *Slugger, text string, l *resolvedLanguage) string {
+		return s.normalize(text)
+	}},
+	{StageTrimSpace, func(s *Slugger, text string, l *resolvedLanguage) string {
+		return strings.TrimSpace(text)
+	}},
+	// Custom substitutions
+	// Always substitute runes first
+	{StageCustomRuneSub, func(s *Slugger, text string, l *resolvedLanguage) string {
+		return SubstituteRune(text, s.cfg.CustomRuneSub)
+	}},
+	{StageCustomSub, func(s *Slugger, text string, l *resolvedLanguage) string {
+		return Substitute(text, s.cfg.CustomSub)
+	}},
+	{StageSymbols, func(s *Slugger, text string, l *resolvedLanguage) string {
+		if !s.cfg.Symbols {
+			return text
+		}
+		return substituteSymbols(text, l.symbols)
+	}},
+	{StageEmoji, func(s *Slugger, text string, l *resolvedLanguage) string {
+		switch s.cfg.Emoji {
+		case EmojiName:
+			return replaceEmoji(text, l.emoji)
+		case EmojiRemove:
+			return replaceEmoji(text, nil)
+		}
+		return text
+	}},
+	{StageLanguage, func(s *Slugger, text string, l *resolvedLanguage) string {
+		if s.cfg.Unicode {
+			// Keep letters, substitute only symbols like "&".
+			return SubstituteRune(text, l.symbolSub())
+		}
+		// Process string with selected substitution language.
+		return SubstituteRune(text, l.sub)
+	}},
+	{StageTransliterate, func(s *Slugger, text string, l *resolvedLanguage) string {
+		if s.cfg.Unicode {
+			return text
+		}
+		// Process all non ASCII symbols
+		return unidecode.Unidecode(text)
+	}},
+	{StageLowercase, func(s *Slugger, text string, l *resolvedLanguage) string {
+		if !s.cfg.Lowercase {
+			return text
+		}
+		return s.lower(text)
+	}},
+	{StageCut, func(s *Slugger, text string, l *resolvedLanguage) string {
+		c := &s.cfg
+		if !c.EnableSmartTruncate && !c.HashTruncate && s.length(text) >= c.MaxLength {
+			return truncateLength(text, c.MaxLength, c.LengthUnit)
+		}
+		return text
+	}},
+	// Process all remaining symbols
+	{StageCleanup, func(s *Slugger, text string, l *resolvedLanguage) string {
+		if s.cfg.Underscore == UnderscoreRemove {
+			text = strings.Replace(text, "_", "", -1)
+		}
+		if s.cfg.Unicode {
+			text = dropOrphanMarks(text)
+		}
+		text = s.regexpNonAuthorized.ReplaceAllString(text, s.sep)
+		if !s.cfg.DisableMultipleDashTrim {
+			text = s.regexpMultipleSeps.ReplaceAllString(text, s.sep)
+		}
+		return text
+	}},
+	{StageTrimEnds, func(s *Slugger, text string, l *resolvedLanguage) string {
+		if s.cfg.DisableEndsTrim {
+			return text
+		}
+		return s.trimEnds(text)
+	}},
+	{StageStopWords, func(s *Slugger, text string, l *resolvedLanguage) string {
+		if !s.cfg.RemoveStopWords {
+			return text
+		}
+		return s.removeStopWords(text, s.stopWords(l))
+	}},
+}
+
+// buildStages returns defaultStages changed by ops. It panics if an op
+// refers to unknown stage or adds a stage with a name already used.
+func buildStages(ops []StageOp) []stage {
+	if len(ops) == 0 {
+		return defaultStages
+	}
+	stages := append([]stage(nil), defaultStages...)
+	for _, op := range ops {
+		i := stageIndex(stages, op.target)
+		if i < 0 {
+			panic("slug: unknown stage " + strconv.Quote(op.target))
+		}
+		if op.kind == stageInsertBefore || op.kind == stageInsertAfter {
+			if stageIndex(stages, op.stage.name) >= 0 {
+				panic("slug: stage " + strconv.Quote(op.stage.name) + " already exists")
+			}
+		}
+
+		switch op.kind {
+		case stageInsertAfter:
+			i++
+			fallthrough
+		case stageInsertBefore:
+			stages = append(stages[:i], append([]stage{op.stage}, stages[i:]...)...)
+		case stageReplace:
+			stages[i] = op.stage
+		case stageRemove:
+			stages = append(stages[:i], stages[i+1:]...)
+		}
+	}
+	return stages
+}
+
+func stageIndex(stages []stage, name string) int {
+	for i, st := range stages {
+		if st.name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// Stages returns names of the stages slugs are made with, in order.
+func (s *Slugger) Stages() []string {
+	names := make([]string, len(s.stages))
+	for i, st := range s.stages {
+		names[i] = st.name
+	}
+	return names
+}
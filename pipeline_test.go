@@ -0,0 +1,112 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+//=============================================================================
+
+var regexpHTMLTags = regexp.MustCompile(`<[^>]*>`)
+
+func stripHTML(text string, lang string) string {
+	return regexpHTMLTags.ReplaceAllString(text, " ")
+}
+
+func TestSluggerStages(t *testing.T) {
+	brands := func(text string, lang string) string {
+		return strings.Replace(text, "Iphone", "iPhone", -1)
+	}
+	abbreviations := func(text string, lang string) string {
+		if lang == "de" {
+			return strings.Replace(text, "z.B.", "zum Beispiel", -1)
+		}
+		return strings.Replace(text, "e.g.", "for example", -1)
+	}
+
+	testCases := []struct {
+		ops       []StageOp
+		lowercase bool
+		lang      string
+		in        string
+		want      string
+	}{
+		{nil, true, "en", "<b>Hello</b> World", "b-hello-b-world"},
+		{[]StageOp{InsertStageBefore(StageTrimSpace, "strip-html", stripHTML)}, true, "en", " <b>Hello</b> World", "hello-world"},
+		{[]StageOp{InsertStageAfter(StageCustomSub, "abbreviations", abbreviations)}, true, "en", "Tips, e.g. Go", "tips-for-example-go"},
+		{[]StageOp{InsertStageAfter(StageCustomSub, "abbreviations", abbreviations)}, true, "de", "Tipps, z.B. Go", "tipps-zum-beispiel-go"},
+		{[]StageOp{InsertStageAfter(StageTransliterate, "brands", brands)}, false, "en", "Ìphone Tips", "iPhone-Tips"},
+		{[]StageOp{RemoveStage(StageLowercase)}, true, "en", "Hello World", "Hello-World"},
+		{[]StageOp{ReplaceStage(StageLanguage, func(text string, lang string) string { return text })}, true, "de", "Äpfel & Birnen", "apfel-birnen"},
+		{
+			[]StageOp{
+				InsertStageBefore(StageTrimSpace, "strip-html", stripHTML),
+				InsertStageAfter("strip-html", "shout", func(text string, lang string) string { return text + "!!!" }),
+				RemoveStage("strip-html"),
+			},
+			true, "en", "<b>Hi</b>", "b-hi-b",
+		},
+	}
+
+	for index, st := range testCases {
+		c := DefaultConfig()
+		c.Stages = st.ops
+		c.Lowercase = st.lowercase
+		got := New(c).MakeLang(st.in, st.lang)
+		if got != st.want {
+			t.Errorf("%d. MakeLang(%#v, %#v) = %#v; want %#v", index, st.in, st.lang, got, st.want)
+		}
+	}
+}
+
+func TestSluggerStageNames(t *testing.T) {
+	want := []string{
+		StageNormalize, StageTrimSpace, StageCustomRuneSub, StageCustomSub,
+		StageSymbols, StageEmoji, StageLanguage, StageTransliterate,
+		StageLowercase, StageCut, StageCleanup, StageTrimEnds, StageStopWords,
+	}
+	if got := New(DefaultConfig()).Stages(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Stages() = %#v; want %#v", got, want)
+	}
+
+	c := DefaultConfig()
+	c.Stages = []StageOp{
+		RemoveStage(StageSymbols),
+		InsertStageBefore(StageNormalize, "first", stripHTML),
+	}
+	got := New(c).Stages()
+	if got[0] != "first" || len(got) != len(want) {
+		t.Errorf("Stages() = %#v", got)
+	}
+	// Default stages are not modified.
+	if got := New(DefaultConfig()).Stages(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Stages() = %#v; want %#v", got, want)
+	}
+}
+
+func TestSluggerStagesPanic(t *testing.T) {
+	testCases := []StageOp{
+		RemoveStage("missing"),
+		InsertStageAfter(StageCleanup, StageLowercase, stripHTML),
+	}
+
+	for index, op := range testCases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%d. New() didn't panic", index)
+				}
+			}()
+			c := DefaultConfig()
+			c.Stages = []StageOp{op}
+			New(c)
+		}()
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"strings"
+)
+
+// ReservedMode defines how slugs listed in Config.Reserved are handled.
+type ReservedMode int
+
+const (
+	// ReservedSuffix appends Config.ReservedAffix to reserved slugs,
+	// e.g. "admin" becomes "admin-page". Slugs reserved by a prefix get
+	// the affix as a prefix instead.
+	ReservedSuffix ReservedMode = iota
+	// ReservedPrefix prepends Config.ReservedAffix to reserved slugs,
+	// e.g. "admin" becomes "page-admin".
+	ReservedPrefix
+	// ReservedReject makes no slug: Make returns empty string and
+	// MakeStrict returns *ValidationError with ErrReserved.
+	ReservedReject
+)
+
+// defaultReservedAffix is used when Config.ReservedAffix is not set.
+const defaultReservedAffix = "page"
+
+// MakeStrict returns slug generated from provided string like Make, or
+// error if the slug is reserved and can't be rewritten.
+// See Slugger.MakeStrict.
+func MakeStrict(s string) (string, error) {
+	return globalSlugger().MakeLangStrict(s, "en")
+}
+
+// MakeLangStrict returns slug generated from provided string like
+// MakeLang, or error if the slug is reserved and can't be rewritten.
+func MakeLangStrict(s string, lang string) (string, error) {
+	return globalSlugger().MakeLangStrict(s, lang)
+}
+
+// MakeStrict returns slug generated from provided string like Make, or
+// *ValidationError with ErrReserved if the slug is listed in
+// Config.Reserved and ReservedMode is ReservedReject, or rewriting it
+// didn't help.
+func (s *Slugger) MakeStrict(text string) (string, error) {
+	return s.MakeLangStrict(text, "en")
+}
+
+// MakeLangStrict returns slug generated from provided string like
+// MakeLang, or error like MakeStrict.
+func (s *Slugger) MakeLangStrict(text string, lang string) (string, error) {
+	return s.makeLangStrict(text, s.language(lang))
+}
+
+// reservedSets splits reserved slugs into exact ones and prefixes, both
+// lower cased.
+func reservedSets(reserved []string) (exact map[string]bool, prefixes []string) {
+	for _, word := range reserved {
+		word = strings.ToLower(strings.TrimSpace(word))
+		if strings.HasSuffix(word, "*") {
+			if word = strings.TrimSuffix(word, "*"); word != "" {
+				prefixes = append(prefixes, word)
+			}
+			continue
+		}
+		if word != "" {
+			if exact == nil {
+				exact = make(map[string]bool)
+			}
+			exact[word] = true
+		}
+	}
+	return exact, prefixes
+}
+
+// reserved returns entry of Config.Reserved matching the slug, prefixes
+// with the trailing "*", or empty string.
+func (s *Slugger) reserved(slug string) string {
+	if slug == "" || s.reservedExact == nil && s.reservedPrefixes == nil {
+		return ""
+	}
+	lower := strings.ToLower(slug)
+	if s.reservedExact[lower] {
+		return lower
+	}
+	for _, prefix := range s.reservedPrefixes {
+		if strings.HasPrefix(lower, prefix) {
+			return prefix + "*"
+		}
+	}
+	return ""
+}
+
+// avoidReserved returns slug made from base and suffixes, rewritten as set
+// by ReservedMode if it's reserved.
+func (s *Slugger) avoidReserved(slug, base string, suffixes []string) (string, error) {
+	word := s.reserved(slug)
+	if word == "" {
+		return slug, nil
+	}
+
+	c := &s.cfg
+	affix := c.ReservedAffix
+	if affix == "" {
+		affix = defaultReservedAffix
+	}
+	suffixed := func() string {
+		return s.fit(base, append([]string{affix}, suffixes...)...)
+	}
+	prefixed := func() string {
+		return s.fit(affix+s.sep+base, suffixes...)
+	}
+
+	var candidates []func() string
+	switch c.ReservedMode {
+	case ReservedSuffix:
+		candidates = []func() string{suffixed, prefixed}
+	case ReservedPrefix:
+		candidates = []func() string{prefixed, suffixed}
+	}
+	for _, candidate := range candidates {
+		if slug := candidate(); s.reserved(slug) == "" {
+			return slug, nil
+		}
+	}
+	return "", &ValidationError{Err: ErrReserved, Pos: -1, Word: word}
+}
@@ -0,0 +1,148 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+//=============================================================================
+
+func TestSluggerReserved(t *testing.T) {
+	reserved := []string{"Admin", "login", "new", "API*", "_next*"}
+
+	testCases := []struct {
+		mode      ReservedMode
+		affix     string
+		maxLength int
+		in        string
+		want      string
+	}{
+		{ReservedSuffix, "", 0, "Hello World", "hello-world"},
+		{ReservedSuffix, "", 0, "Admin", "admin-page"},
+		{ReservedSuffix, "", 0, "LOGIN!", "login-page"},
+		{ReservedSuffix, "", 0, "New", "new-page"},
+		{ReservedSuffix, "", 0, "New York", "new-york"},
+		{ReservedSuffix, "", 0, "Admins", "admins"},
+		{ReservedSuffix, "", 0, "API", "page-api"},
+		{ReservedSuffix, "", 0, "API docs", "page-api-docs"},
+		{ReservedSuffix, "", 0, "Apiary", "page-apiary"},
+		{ReservedSuffix, "post", 0, "Admin", "admin-post"},
+		{ReservedSuffix, "new", 0, "Login", "login-new"},
+		{ReservedSuffix, "", 8, "Login", "log-page"},
+		{ReservedPrefix, "", 0, "Admin", "page-admin"},
+		{ReservedPrefix, "", 0, "API docs", "page-api-docs"},
+		{ReservedPrefix, "api", 0, "API docs", ""},
+		{ReservedReject, "", 0, "Admin", ""},
+		{ReservedReject, "", 0, "Hello World", "hello-world"},
+	}
+
+	for index, st := range testCases {
+		c := DefaultConfig()
+		c.Reserved = reserved
+		c.ReservedMode = st.mode
+		c.ReservedAffix = st.affix
+		c.MaxLength = st.maxLength
+		got := New(c).Make(st.in)
+		if got != st.want {
+			t.Errorf("%d. ReservedMode = %v; Make(%#v) = %#v; want %#v", index, st.mode, st.in, got, st.want)
+		}
+	}
+}
+
+func TestSluggerMakeStrict(t *testing.T) {
+	c := DefaultConfig()
+	c.Reserved = []string{"admin", "api*"}
+	c.ReservedMode = ReservedReject
+	s := New(c)
+
+	testCases := []struct {
+		in   string
+		want string
+		word string
+	}{
+		{"Hello World", "hello-world", ""},
+		{"Admin", "", "admin"},
+		{"API v2", "", "api*"},
+	}
+
+	for index, st := range testCases {
+		got, err := s.MakeStrict(st.in)
+		if got != st.want {
+			t.Errorf("%d. MakeStrict(%#v) = %#v; want %#v", index, st.in, got, st.want)
+		}
+		var verr *ValidationError
+		switch {
+		case st.word == "" && err != nil:
+			t.Errorf("%d. MakeStrict(%#v) error = %v", index, st.in, err)
+		case st.word != "" && (!errors.As(err, &verr) || verr.Err != ErrReserved || verr.Word != st.word):
+			t.Errorf("%d. MakeStrict(%#v) error = %v; want %v %#v", index, st.in, err, ErrReserved, st.word)
+		}
+	}
+}
+
+func TestSluggerValidateReserved(t *testing.T) {
+	c := DefaultConfig()
+	c.Reserved = []string{"admin", "api*"}
+	s := New(c)
+
+	testCases := []struct {
+		in   string
+		want string
+	}{
+		{"admin", `slug: slug is reserved: "admin"`},
+		{"api-v2", `slug: slug is reserved: "api*"`},
+		{"admin-page", ""},
+		{"my-api", ""},
+	}
+
+	for index, st := range testCases {
+		err := s.Validate(st.in)
+		got := ""
+		if err != nil {
+			got = err.Error()
+		}
+		if got != st.want {
+			t.Errorf("%d. Validate(%#v) = %#v; want %#v", index, st.in, got, st.want)
+		}
+		if s.IsSlug(st.in) != (st.want == "") {
+			t.Errorf("%d. IsSlug(%#v) = %v", index, st.in, st.want != "")
+		}
+	}
+}
+
+func TestMakeUniqueReserved(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		mode ReservedMode
+		in   string
+		want []string
+	}{
+		{ReservedSuffix, "Admin", []string{"admin-page", "admin-2", "admin-3"}},
+		{ReservedPrefix, "API", []string{"page-api", "page-api-2"}},
+		{ReservedReject, "Admin", []string{"admin-2", "admin-3"}},
+	}
+
+	for index, st := range testCases {
+		c := DefaultConfig()
+		c.Reserved = []string{"admin", "api*"}
+		c.ReservedMode = st.mode
+		s := New(c)
+		store := NewMemoryStore()
+		for _, want := range st.want {
+			got, err := s.MakeUnique(ctx, st.in, store)
+			if err != nil {
+				t.Fatalf("%d. MakeUnique() error = %v", index, err)
+			}
+			if got != want {
+				t.Errorf("%d. ReservedMode = %v; MakeUnique(%#v) = %#v; want %#v", index, st.mode, st.in, got, want)
+			}
+		}
+	}
+}
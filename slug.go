@@ -7,13 +7,11 @@ package slug
 
 import (
 	"bytes"
+	"reflect"
 	"regexp"
 	"sort"
-	"strconv"
 	"strings"
-	"time"
-
-	"github.com/gosimple/unidecode"
+	"sync/atomic"
 )
 
 var (
@@ -27,6 +25,7 @@ var (
 	// If MaxLength is smaller than length of the first word, then returned
 	// slug will contain only substring from the first word truncated
 	// after MaxLength.
+	// Appended timestamp counts towards MaxLength.
 	MaxLength int
 
 	// EnableSmartTruncate defines if cutting with MaxLength is smart.
@@ -65,91 +64,55 @@ func Make(s string) (slug string) {
 // MakeLang returns slug generated from provided string and will use provided
 // language for chars substitution.
 func MakeLang(s string, lang string) (slug string) {
-	slug = strings.TrimSpace(s)
-
-	// Custom substitutions
-	// Always substitute runes first
-	slug = SubstituteRune(slug, CustomRuneSub)
-	slug = Substitute(slug, CustomSub)
-
-	// Process string with selected substitution language.
-	// Catch ISO 3166-1, ISO 639-1:2002 and ISO 639-3:2007.
-	switch strings.ToLower(lang) {
-	case "bg", "bgr":
-		slug = SubstituteRune(slug, bgSub)
-	case "cs", "ces":
-		slug = SubstituteRune(slug, csSub)
-	case "de", "deu":
-		slug = SubstituteRune(slug, deSub)
-	case "en", "eng":
-		slug = SubstituteRune(slug, enSub)
-	case "es", "spa":
-		slug = SubstituteRune(slug, esSub)
-	case "fi", "fin":
-		slug = SubstituteRune(slug, fiSub)
-	case "fr", "fra":
-		slug = SubstituteRune(slug, frSub)
-	case "gr", "el", "ell":
-		slug = SubstituteRune(slug, grSub)
-	case "hu", "hun":
-		slug = SubstituteRune(slug, huSub)
-	case "id", "idn", "ind":
-		slug = SubstituteRune(slug, idSub)
-	case "it", "ita":
-		slug = SubstituteRune(slug, itSub)
-	case "kz", "kk", "kaz":
-		slug = SubstituteRune(slug, kkSub)
-	case "nb", "nob":
-		slug = SubstituteRune(slug, nbSub)
-	case "nl", "nld":
-		slug = SubstituteRune(slug, nlSub)
-	case "nn", "nno":
-		slug = SubstituteRune(slug, nnSub)
-	case "pl", "pol":
-		slug = SubstituteRune(slug, plSub)
-	case "pt", "prt", "pt-br", "br", "bra", "por":
-		slug = SubstituteRune(slug, ptSub)
-	case "ro", "rou":
-		slug = SubstituteRune(slug, roSub)
-	case "sl", "slv":
-		slug = SubstituteRune(slug, slSub)
-	case "sv", "swe":
-		slug = SubstituteRune(slug, svSub)
-	case "tr", "tur":
-		slug = SubstituteRune(slug, trSub)
-	default: // fallback to "en" if lang not found
-		slug = SubstituteRune(slug, enSub)
-	}
-
-	// Process all non ASCII symbols
-	slug = unidecode.Unidecode(slug)
-
-	if Lowercase {
-		slug = strings.ToLower(slug)
-	}
+	return globalSlugger().MakeLang(s, lang)
+}
 
-	if !EnableSmartTruncate && len(slug) >= MaxLength {
-		slug = slug[:MaxLength]
-	}
+// MakeLangs returns slug generated from provided string using the first
+// registered language from provided preferred languages, and the main code
+// of the used language.
+func MakeLangs(s string, langs ...string) (slug string, lang string) {
+	return globalSlugger().MakeLangs(s, langs...)
+}
 
-	// Process all remaining symbols
-	slug = regexpNonAuthorizedChars.ReplaceAllString(slug, "-")
-	if !DisableMultipleDashTrim {
-		slug = regexpMultipleDashes.ReplaceAllString(slug, "-")
-	}
-	if !DisableEndsTrim {
-		slug = strings.Trim(slug, "-_")
-	}
+// variablesSlugger caches the Slugger made from the package level
+// variables, so it's made again only after they change.
+var variablesSlugger atomic.Value
 
-	if MaxLength > 0 && EnableSmartTruncate {
-		slug = smartTruncate(slug)
+// globalSlugger returns the Slugger set by Configure or, when there is none,
+// a Slugger reading the package level variables. Maps are not copied, so
+// changes to CustomSub and CustomRuneSub are seen by the next call.
+func globalSlugger() *Slugger {
+	if s, _ := configured.Load().(*Slugger); s != nil {
+		return s
 	}
-
-	if AppendTimestamp {
-		slug = slug + "-" + timestamp()
+	if s, _ := variablesSlugger.Load().(*Slugger); s != nil && usesVariables(&s.cfg) {
+		return s
 	}
+	s := newSlugger(Config{
+		CustomSub:               CustomSub,
+		CustomRuneSub:           CustomRuneSub,
+		MaxLength:               MaxLength,
+		EnableSmartTruncate:     EnableSmartTruncate,
+		Lowercase:               Lowercase,
+		DisableMultipleDashTrim: DisableMultipleDashTrim,
+		DisableEndsTrim:         DisableEndsTrim,
+		AppendTimestamp:         AppendTimestamp,
+	})
+	variablesSlugger.Store(s)
+	return s
+}
 
-	return slug
+// usesVariables reports whether config has the current values of the
+// package level variables. Maps are compared by identity.
+func usesVariables(c *Config) bool {
+	return c.MaxLength == MaxLength &&
+		c.EnableSmartTruncate == EnableSmartTruncate &&
+		c.Lowercase == Lowercase &&
+		c.DisableMultipleDashTrim == DisableMultipleDashTrim &&
+		c.DisableEndsTrim == DisableEndsTrim &&
+		c.AppendTimestamp == AppendTimestamp &&
+		reflect.ValueOf(c.CustomSub).Pointer() == reflect.ValueOf(CustomSub).Pointer() &&
+		reflect.ValueOf(c.CustomRuneSub).Pointer() == reflect.ValueOf(CustomRuneSub).Pointer()
 }
 
 // Substitute returns string with superseded all substrings from
@@ -183,43 +146,38 @@ func SubstituteRune(s string, sub map[rune]string) string {
 	return buf.String()
 }
 
-func smartTruncate(text string) string {
-	if len(text) <= MaxLength {
+func smartTruncate(text string, maxLength int, sep string, unit LengthUnit) string {
+	cut := truncateLength(text, maxLength, unit)
+	if len(cut) == len(text) {
 		return text
 	}
 
-	// If slug is too long, we need to find the last '-' before MaxLength, and
-	// we cut there.
-	// If we don't find any, we have only one word, and we cut at MaxLength.
-	for i := MaxLength; i >= 0; i-- {
-		if text[i] == '-' {
-			return text[:i]
-		}
+	// If slug is too long, we need to find the last separator before
+	// maxLength, and we cut there.
+	// If we don't find any, we have only one word, and we cut at maxLength.
+	end := len(cut) + len(sep)
+	if end > len(text) {
+		end = len(text)
 	}
-	return text[:MaxLength]
-}
-
-// timestamp returns current timestamp as string
-func timestamp() string {
-	return strconv.FormatInt(time.Now().Unix(), 10)
+	if i := strings.LastIndex(text[:end], sep); i >= 0 {
+		return text[:i]
+	}
+	return cut
 }
 
 // IsSlug returns True if provided text does not contain white characters,
 // punctuation, all letters are lower case and only from ASCII range.
 // It could contain `-` and `_` but not at the beginning or end of the text.
 // It should be in range of the MaxLength var if specified.
-// All output from slug.Make(text) should pass this test.
+// All output from slug.Make(text) should pass this test, whatever the
+// options. Use Validate to know why text isn't a slug.
 func IsSlug(text string) bool {
-	if text == "" ||
-		(MaxLength > 0 && len(text) > MaxLength) ||
-		text[0] == '-' || text[0] == '_' ||
-		text[len(text)-1] == '-' || text[len(text)-1] == '_' {
-		return false
-	}
-	for _, c := range text {
-		if (c < 'a' || c > 'z') && c != '-' && c != '_' && (c < '0' || c > '9') {
-			return false
-		}
-	}
-	return true
+	return globalSlugger().IsSlug(text)
+}
+
+// IsUnicodeSlug returns True if provided text could be returned by Make in
+// Unicode mode: like IsSlug, but lower case letters, numbers and combining
+// marks from any script are allowed.
+func IsUnicodeSlug(text string) bool {
+	return globalSlugger().validate(text, true) == nil
 }
@@ -413,7 +413,12 @@ func TestSlugMakeAppendTimestamp(t *testing.T) {
 		}
 		got := Make(st.in)
 		if st.appendTimestamp {
-			want := regexp.MustCompile(`^` + st.want + `-\d{10}$`)
+			pattern := st.want + `-\d{10}`
+			if st.want == "" {
+				// Text without letters and numbers gives just the timestamp.
+				pattern = `\d{10}`
+			}
+			want := regexp.MustCompile(`^` + pattern + `$`)
 			if !want.MatchString(got) {
 				t.Errorf(
 					"%d. AppendTimestamp = %v; Make(%#v) = %#v; want %#v",
@@ -577,6 +582,63 @@ func BenchmarkMakeLongAscii(b *testing.B) {
 	}
 }
 
+func BenchmarkMakeSlug(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		Make("already-a-slug")
+	}
+}
+
+// benchmarkSluggerMake measures Slugger.Make with the default stages run in
+// one pass, or one by one when fused is false.
+func benchmarkSluggerMake(b *testing.B, in string, fused bool) {
+	s := New(DefaultConfig())
+	staged := New(DefaultConfig())
+	staged.fused = false
+	if got, want := s.Make(in), staged.Make(in); got != want {
+		b.Fatalf("Make(%#v) = %#v; want %#v", in, got, want)
+	}
+	s.fused = fused
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		s.Make(in)
+	}
+}
+
+func BenchmarkSluggerMakeShortAscii(b *testing.B) {
+	benchmarkSluggerMake(b, "Hello world", true)
+}
+
+func BenchmarkSluggerMakeShortAsciiStages(b *testing.B) {
+	benchmarkSluggerMake(b, "Hello world", false)
+}
+
+func BenchmarkSluggerMakeShort(b *testing.B) {
+	benchmarkSluggerMake(b, "хелло ворлд", true)
+}
+
+func BenchmarkSluggerMakeShortStages(b *testing.B) {
+	benchmarkSluggerMake(b, "хелло ворлд", false)
+}
+
+func BenchmarkSluggerMakeSlug(b *testing.B) {
+	benchmarkSluggerMake(b, "already-a-slug", true)
+}
+
+func BenchmarkSluggerMakeSlugStages(b *testing.B) {
+	benchmarkSluggerMake(b, "already-a-slug", false)
+}
+
+func BenchmarkSluggerMakeMediumAscii(b *testing.B) {
+	benchmarkSluggerMake(b, "ABCDE FGHIJ KLMNO PQRST UWXYZ ABCDE FGHIJ KLMNO PQRST UWXYZ ABCDE", true)
+}
+
+func BenchmarkSluggerMakeMediumAsciiStages(b *testing.B) {
+	benchmarkSluggerMake(b, "ABCDE FGHIJ KLMNO PQRST UWXYZ ABCDE FGHIJ KLMNO PQRST UWXYZ ABCDE", false)
+}
+
 func BenchmarkSubstituteRuneShort(b *testing.B) {
 	shortStr := "Hello/Hi world"
 	subs := map[rune]string{'o': "no", '/': "slash"}
@@ -623,12 +685,11 @@ func BenchmarkSubstituteRuneLong(b *testing.B) {
 
 func BenchmarkSmartTruncateShort(b *testing.B) {
 	shortStr := "Hello-world"
-	MaxLength = 8
 
 	b.ReportAllocs()
 	b.ResetTimer()
 	for n := 0; n < b.N; n++ {
-		smartTruncate(shortStr)
+		smartTruncate(shortStr, 8, "-", LengthBytes)
 	}
 }
 
@@ -648,12 +709,11 @@ func BenchmarkSmartTruncateLong(b *testing.B) {
 		"nisl.-Etiam-varius-imperdiet-placerat.-Aliquam-euismod-lacus-arcu,-" +
 		"ultrices-hendrerit-est-pellentesque-vel.-Aliquam-sit-amet-laoreet-leo.-" +
 		"Integer-eros-libero,-mollis-sed-posuere."
-	MaxLength = 256
 
 	b.ReportAllocs()
 	b.ResetTimer()
 	for n := 0; n < b.N; n++ {
-		smartTruncate(longStr)
+		smartTruncate(longStr, 256, "-", LengthBytes)
 	}
 }
 
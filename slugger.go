@@ -0,0 +1,653 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Config stores options used by Slugger. Fields have the same meaning as
+// the package level variables with the same names.
+// Zero value of Config isn't the default one, use DefaultConfig to get it.
+type Config struct {
+	// CustomSub stores custom substitution map.
+	CustomSub map[string]string
+	// CustomRuneSub stores custom rune substitution map.
+	CustomRuneSub map[rune]string
+
+	// MaxLength stores maximum slug length.
+	// By default slugs aren't shortened.
+	MaxLength int
+
+	// MaxPathLength limits length of the whole path made by MakePath,
+	// including delimiters. MaxLength limits each segment.
+	// By default paths aren't shortened.
+	MaxPathLength int
+
+	// LengthUnit defines how MaxLength is measured. Slugs are never cut
+	// in the middle of a character, whatever the unit.
+	// Default is LengthBytes.
+	LengthUnit LengthUnit
+
+	// EnableSmartTruncate defines if cutting with MaxLength is smart.
+	EnableSmartTruncate bool
+
+	// HashTruncate defines if slug cut because of MaxLength ends with hash
+	// of the whole slug, so different long inputs give different slugs.
+	HashTruncate bool
+
+	// HashLength is length of the hash added by HashTruncate.
+	// Default is 8.
+	HashLength int
+
+	// HashAlphabet lists ASCII chars used by the hash added by HashTruncate.
+	// Default is "0123456789abcdefghijklmnopqrstuvwxyz".
+	HashAlphabet string
+
+	// Lowercase defines if the resulting slug is transformed to lowercase.
+	Lowercase bool
+
+	// DisableMultipleDashTrim defines if multiple dashes should be preserved.
+	DisableMultipleDashTrim bool
+
+	// DisableEndsTrim defines if the slug should keep leading and trailing
+	// dashes and underscores.
+	DisableEndsTrim bool
+
+	// AppendTimestamp appends timestamp to the end of the slug.
+	// Its length is reserved from MaxLength before the slug is shortened.
+	// Text without letters and numbers gives just the timestamp.
+	AppendTimestamp bool
+
+	// TimestampFormat formats timestamp appended with AppendTimestamp.
+	// Default is TimestampUnix.
+	TimestampFormat TimestampFunc
+
+	// Clock provides time for timestamps. Default is the system clock.
+	Clock Clock
+
+	// DefaultLanguage is used when language passed to MakeLang isn't
+	// registered. Default is "en".
+	DefaultLanguage string
+
+	// AutoConfidence is the minimal confidence of language detected by
+	// MakeAuto. Below it the default language is used. Default is 0.
+	AutoConfidence float64
+
+	// Normalization is Unicode normalization form applied to the text
+	// before any substitutions, so equivalent inputs like precomposed "é"
+	// and "e" with combining accent give the same slug.
+	// Default is NormNone.
+	Normalization NormForm
+
+	// Symbols replaces symbols like "%", "€" or "©", and currency codes next
+	// to numbers, with words in the slug language, e.g. "5€" with "5-euro".
+	Symbols bool
+
+	// Emoji defines what happens with emoji. Default is EmojiKeep.
+	Emoji EmojiMode
+
+	// Unicode keeps letters and numbers of any script instead of
+	// transliterating them to ASCII, e.g. "東京-旅行".
+	// Language substitutions are used only for symbols like "&".
+	// With Lowercase, text is case folded, e.g. "Straße" gives "strasse"
+	// and Greek final sigma becomes "σ".
+	Unicode bool
+
+	// Separator replaces white characters and punctuation between words.
+	// It should not contain letters or digits. Default is "-".
+	Separator string
+
+	// Underscore defines what happens with underscores.
+	// Default is UnderscoreKeep.
+	Underscore UnderscoreMode
+
+	// RemoveStopWords removes stop words of the slug language, like "the"
+	// or "to" in English. Stop words are kept when removing them would
+	// leave less than half of the words, e.g. in "to be or not to be".
+	RemoveStopWords bool
+
+	// StopWords replaces built-in stop words. Keys are language codes like
+	// "en" or "de", values are words written as in the text before
+	// transliteration.
+	StopWords map[string][]string
+
+	// UniqueSuffix generates suffixes tried by MakeUnique when slug is
+	// already taken. Default is CounterSuffix.
+	UniqueSuffix SuffixFunc
+
+	// UniqueMaxAttempts limits how many suffixes MakeUnique tries.
+	// Default is 100.
+	UniqueMaxAttempts int
+
+	// Reserved lists slugs which must not be made, like "admin" or "login".
+	// They are compared case insensitively. Entries ending with "*" are
+	// prefixes, e.g. "api*" reserves "api", "api-v2" and "apis".
+	Reserved []string
+
+	// ReservedMode defines what happens with reserved slugs.
+	// Default is ReservedSuffix.
+	ReservedMode ReservedMode
+
+	// ReservedAffix is added to reserved slugs by ReservedSuffix and
+	// ReservedPrefix. Default is "page".
+	ReservedAffix string
+
+	// Stages inserts, replaces or removes stages slugs are made with,
+	// applied in order to the default stages, e.g.
+	// InsertStageBefore(StageTrimSpace, "strip-html", stripHTML).
+	// New panics if an op refers to unknown stage.
+	Stages []StageOp
+}
+
+// UnderscoreMode defines how underscores are handled.
+type UnderscoreMode int
+
+const (
+	// UnderscoreKeep keeps underscores in the slug.
+	UnderscoreKeep UnderscoreMode = iota
+	// UnderscoreToSeparator replaces underscores with the separator.
+	UnderscoreToSeparator
+	// UnderscoreRemove removes underscores from the slug.
+	UnderscoreRemove
+)
+
+// NormForm defines Unicode normalization form of the text.
+type NormForm int
+
+const (
+	// NormNone keeps the text as it is.
+	NormNone NormForm = iota
+	// NormNFC composes characters, e.g. "e" with combining accent to "é".
+	NormNFC
+	// NormNFKC composes characters and replaces compatibility ones,
+	// e.g. "ﬁ" with "fi", "①" with "1" and fullwidth "Ｈ" with "H".
+	NormNFKC
+	// NormNFKD decomposes characters and replaces compatibility ones.
+	// Accents become combining marks, so language substitutions of
+	// precomposed letters like "ä" aren't used.
+	NormNFKD
+)
+
+// DefaultConfig returns Config with the same values as the package level
+// variables have by default.
+func DefaultConfig() Config {
+	return Config{
+		EnableSmartTruncate: true,
+		Lowercase:           true,
+	}
+}
+
+// Slugger generates slugs using its own Config, so many Sluggers with
+// different options could be used at the same time.
+// Slugger is safe for concurrent use.
+type Slugger struct {
+	cfg Config
+
+	sep                 string
+	regexpNonAuthorized *regexp.Regexp
+	regexpMultipleSeps  *regexp.Regexp
+
+	// stages are Config.Stages applied to defaultStages.
+	stages []stage
+	// fused is true if the default stages are run by runFused.
+	fused bool
+
+	// reservedExact and reservedPrefixes store lower cased Config.Reserved.
+	reservedExact    map[string]bool
+	reservedPrefixes []string
+
+	// stopWordsCache stores stop words from Config.StopWords, or all stop
+	// words in Unicode mode or with normalization, by language.
+	stopWordsCache sync.Map
+}
+
+// New returns Slugger using provided config. Substitution maps are copied,
+// so later changes to them don't affect returned Slugger.
+func New(c Config) *Slugger {
+	c.CustomSub = copySub(c.CustomSub)
+	c.CustomRuneSub = copyRuneSub(c.CustomRuneSub)
+	c.StopWords = copyStopWords(c.StopWords)
+	c.Reserved = append([]string(nil), c.Reserved...)
+	c.Stages = append([]StageOp(nil), c.Stages...)
+	return newSlugger(c)
+}
+
+// newSlugger returns Slugger using provided config without copying it.
+func newSlugger(c Config) *Slugger {
+	s := &Slugger{
+		cfg:                 c,
+		sep:                 c.Separator,
+		regexpNonAuthorized: regexpNonAuthorizedChars,
+		regexpMultipleSeps:  regexpMultipleDashes,
+	}
+	if s.sep == "" {
+		s.sep = "-"
+	}
+	s.stages = buildStages(c.Stages)
+	s.fused = canFuse(&c, s.sep)
+	s.reservedExact, s.reservedPrefixes = reservedSets(c.Reserved)
+	if s.sep == "-" && c.Underscore == UnderscoreKeep && !c.Unicode {
+		return s
+	}
+
+	authorized := "a-zA-Z0-9"
+	if c.Unicode {
+		authorized = `\p{L}\p{N}\p{M}`
+	}
+	if c.Underscore == UnderscoreKeep {
+		authorized += "_"
+	}
+	s.regexpNonAuthorized = regexp.MustCompile("[^" + authorized + "]")
+	s.regexpMultipleSeps = regexp.MustCompile("(?:" + regexp.QuoteMeta(s.sep) + ")+")
+	return s
+}
+
+// configured stores *Slugger set by Configure. It's nil when the package
+// level functions should use the package level variables.
+var configured atomic.Value
+
+// Configure atomically replaces the configuration used by the package level
+// functions (Make, MakeLang, IsSlug, ...) with a snapshot of provided config.
+// Every call reads one consistent configuration, so it's safe to call
+// Configure while other goroutines are making slugs.
+// After Configure the package level variables like MaxLength or CustomSub
+// are ignored until ResetConfig is called.
+func Configure(c Config) {
+	configured.Store(New(c))
+}
+
+// ResetConfig reverts the package level functions to use the package level
+// variables.
+func ResetConfig() {
+	configured.Store((*Slugger)(nil))
+}
+
+// CurrentConfig returns the configuration used by the package level
+// functions.
+func CurrentConfig() Config {
+	return globalSlugger().Config()
+}
+
+// Config returns copy of the Slugger config.
+func (s *Slugger) Config() Config {
+	c := s.cfg
+	c.CustomSub = copySub(c.CustomSub)
+	c.CustomRuneSub = copyRuneSub(c.CustomRuneSub)
+	c.StopWords = copyStopWords(c.StopWords)
+	c.Reserved = append([]string(nil), c.Reserved...)
+	c.Stages = append([]StageOp(nil), c.Stages...)
+	return c
+}
+
+// Make returns slug generated from provided string. Will use "en" as language
+// substitution.
+func (s *Slugger) Make(text string) string {
+	return s.MakeLang(text, "en")
+}
+
+// MakeLangs returns slug generated from provided string using the first
+// registered language from provided preferred languages, and the main code
+// of the used language. Every language is tried with its fallbacks,
+// e.g. "de-AT" then "de", before the next one. When none matches, the
+// default language is used.
+func (s *Slugger) MakeLangs(text string, langs ...string) (slug string, lang string) {
+	l := resolveTags(langs)
+	if l == nil {
+		l = s.defaultLanguage()
+	}
+	return s.makeLang(text, l), l.code
+}
+
+// MakeLang returns slug generated from provided string and will use provided
+// language for chars substitution. Language could be BCP 47 tag like
+// "de-AT" or POSIX locale like "de_AT.UTF-8"; if there are no rules for
+// the region or script, rules of the language ("de") are used, and if
+// there are none either, the default language ones.
+func (s *Slugger) MakeLang(text string, lang string) (slug string) {
+	return s.makeLang(text, s.language(lang))
+}
+
+// language returns registered language matching lang or the default one.
+func (s *Slugger) language(lang string) *resolvedLanguage {
+	if l := resolveTags([]string{lang}); l != nil {
+		return l
+	}
+	return s.defaultLanguage()
+}
+
+// defaultLanguage returns Config.DefaultLanguage, falling back to "en".
+func (s *Slugger) defaultLanguage() *resolvedLanguage {
+	if s.cfg.DefaultLanguage != "" {
+		if l := resolveTags([]string{s.cfg.DefaultLanguage}); l != nil {
+			return l
+		}
+	}
+	return lookupLanguage("en")
+}
+
+func (s *Slugger) makeLang(text string, l *resolvedLanguage) (slug string) {
+	slug, _ = s.makeLangStrict(text, l)
+	return slug
+}
+
+// makeLangStrict returns slug like makeLang, or error if the slug is
+// reserved and can't be rewritten.
+func (s *Slugger) makeLangStrict(text string, l *resolvedLanguage) (string, error) {
+	base, suffixes := s.makeParts(text, l)
+	return s.finish(base, suffixes)
+}
+
+// finish returns slug made from base and suffixes, shortened to MaxLength
+// and rewritten if it's reserved.
+func (s *Slugger) finish(base string, suffixes []string) (string, error) {
+	return s.avoidReserved(s.fit(base, suffixes...), base, suffixes)
+}
+
+// makeParts returns slug generated from provided string, not shortened
+// yet, and suffixes which should be appended to it, like the timestamp.
+func (s *Slugger) makeParts(text string, l *resolvedLanguage) (slug string, suffixes []string) {
+	return s.runStages(text, l), s.suffixes()
+}
+
+// runStages returns text passed through all stages.
+func (s *Slugger) runStages(text string, l *resolvedLanguage) string {
+	if s.fused {
+		return s.runFused(text, l)
+	}
+	for _, st := range s.stages {
+		text = st.fn(s, text, l)
+	}
+	return text
+}
+
+// suffixes returns suffixes appended to every slug, like the timestamp.
+func (s *Slugger) suffixes() (suffixes []string) {
+	if s.cfg.AppendTimestamp {
+		suffixes = append(suffixes, s.timestamp())
+	}
+	return suffixes
+}
+
+// fit joins slug and suffixes with the separator. Length of the suffixes
+// is reserved first and only the slug is shortened, so the result is never
+// longer than MaxLength. With HashTruncate, shortened slug ends with hash
+// of the whole slug, before the other suffixes.
+func (s *Slugger) fit(slug string, suffixes ...string) string {
+	return s.fitLength(slug, s.cfg.MaxLength, s.cfg.LengthUnit, suffixes...)
+}
+
+// fitLength is fit with maximum length measured in provided unit.
+func (s *Slugger) fitLength(slug string, maxLength int, unit LengthUnit, suffixes ...string) string {
+	c := &s.cfg
+	tail := strings.Join(suffixes, s.sep)
+	if maxLength <= 0 {
+		return joinSuffix(slug, tail, s.sep)
+	}
+
+	budget := maxLength
+	if tail != "" {
+		budget -= textLength(s.sep, unit) + textLength(tail, unit)
+	}
+	if c.HashTruncate && textLength(slug, unit) > budget {
+		hash := s.hash(slug)
+		tail = joinSuffix(hash, tail, s.sep)
+		budget -= textLength(s.sep, unit) + textLength(hash, unit)
+	}
+	if tail == "" {
+		if c.EnableSmartTruncate {
+			slug = smartTruncate(slug, budget, s.sep, unit)
+		} else {
+			slug = truncateLength(slug, budget, unit)
+		}
+		// The default ASCII slugs are left as they always were.
+		if (c.Unicode || s.sep != "-") && !c.DisableEndsTrim {
+			slug = s.trimEnds(slug)
+		}
+		return slug
+	}
+
+	if budget <= 0 {
+		return s.trimEnds(truncateLength(tail, maxLength, unit))
+	}
+	if textLength(slug, unit) > budget {
+		if c.EnableSmartTruncate {
+			slug = smartTruncate(slug, budget, s.sep, unit)
+		} else {
+			slug = truncateLength(slug, budget, unit)
+		}
+		slug = s.trimEnds(slug)
+	}
+	return joinSuffix(slug, tail, s.sep)
+}
+
+// joinSuffix joins slug and non-empty suffix with the separator.
+func joinSuffix(slug, suffix, sep string) string {
+	switch {
+	case suffix == "":
+		return slug
+	case slug == "":
+		return suffix
+	}
+	return slug + sep + suffix
+}
+
+// stopWords returns set of transliterated stop words of the language,
+// taken from Config.StopWords if set for any of the language codes.
+// In Unicode mode stop words are only lower cased, not transliterated.
+func (s *Slugger) stopWords(l *resolvedLanguage) map[string]bool {
+	words, custom := l.stopWords, false
+	for _, code := range l.codes {
+		if w, ok := s.cfg.StopWords[code]; ok {
+			words, custom = w, true
+			break
+		}
+	}
+	if !custom && !s.cfg.Unicode && s.cfg.Normalization == NormNone {
+		return l.stopWordsSet()
+	}
+	if set, ok := s.stopWordsCache.Load(l); ok {
+		return set.(map[string]bool)
+	}
+	if s.cfg.Normalization != NormNone {
+		normalized := make([]string, len(words))
+		for i, w := range words {
+			normalized[i] = s.normalize(w)
+		}
+		words = normalized
+	}
+	var set map[string]bool
+	if s.cfg.Unicode {
+		set = make(map[string]bool, len(words))
+		for _, w := range words {
+			set[s.lower(SubstituteRune(w, l.symbolSub()))] = true
+		}
+	} else {
+		set = transliterateWords(words, l.sub)
+	}
+	s.stopWordsCache.Store(l, set)
+	return set
+}
+
+// removeStopWords removes stop words from the slug, unless less than half
+// of the words would be left. Empty words between repeated separators and
+// words made only of underscores aren't counted.
+func (s *Slugger) removeStopWords(slug string, stop map[string]bool) string {
+	if len(stop) == 0 {
+		return slug
+	}
+	words := strings.Split(slug, s.sep)
+	kept := make([]string, 0, len(words))
+	count, removed := 0, 0
+	for _, w := range words {
+		if strings.Trim(w, "_") == "" {
+			kept = append(kept, w)
+			continue
+		}
+		count++
+		if stop[s.lower(w)] {
+			removed++
+			continue
+		}
+		kept = append(kept, w)
+	}
+	if removed == 0 || 2*(count-removed) < count {
+		return slug
+	}
+	slug = strings.Join(kept, s.sep)
+	if !s.cfg.DisableEndsTrim {
+		slug = s.trimEnds(slug)
+	}
+	return slug
+}
+
+// hash returns hash of the slug added by HashTruncate.
+func (s *Slugger) hash(slug string) string {
+	length := s.cfg.HashLength
+	if length <= 0 {
+		length = 8
+	}
+	alphabet := s.cfg.HashAlphabet
+	if alphabet == "" {
+		alphabet = base36
+	}
+	return hashString(slug, length, alphabet)
+}
+
+// trimEnds removes separators and underscores from both ends of the slug.
+func (s *Slugger) trimEnds(slug string) string {
+	if len(s.sep) == 1 {
+		return strings.Trim(slug, s.sep+"_")
+	}
+	for {
+		switch {
+		case strings.HasPrefix(slug, s.sep):
+			slug = slug[len(s.sep):]
+		case strings.HasPrefix(slug, "_"):
+			slug = slug[1:]
+		case strings.HasSuffix(slug, s.sep):
+			slug = slug[:len(slug)-len(s.sep)]
+		case strings.HasSuffix(slug, "_"):
+			slug = slug[:len(slug)-1]
+		default:
+			return slug
+		}
+	}
+}
+
+// length returns length of the text in Config.LengthUnit.
+func (s *Slugger) length(text string) int {
+	return textLength(text, s.cfg.LengthUnit)
+}
+
+// normalize returns text in the Config.Normalization form.
+func (s *Slugger) normalize(text string) string {
+	switch s.cfg.Normalization {
+	case NormNFC:
+		return norm.NFC.String(text)
+	case NormNFKC:
+		return norm.NFKC.String(text)
+	case NormNFKD:
+		return norm.NFKD.String(text)
+	}
+	return text
+}
+
+// lower returns lower case text. In Unicode mode full Unicode case
+// folding is used, so e.g. "Straße" and "STRASSE" both give "strasse".
+func (s *Slugger) lower(text string) string {
+	if s.cfg.Unicode {
+		return cases.Fold().String(text)
+	}
+	return strings.ToLower(text)
+}
+
+// dropOrphanMarks removes variation selectors and combining marks which
+// don't follow a letter or number, e.g. U+FE0F of "☕️". Otherwise they
+// would be kept in Unicode mode after the char they belong to is replaced
+// with the separator.
+func dropOrphanMarks(text string) string {
+	i := strings.IndexFunc(text, func(r rune) bool { return unicode.Is(unicode.M, r) })
+	if i < 0 {
+		return text
+	}
+	var b strings.Builder
+	b.Grow(len(text))
+	b.WriteString(text[:i])
+	r, _ := utf8.DecodeLastRuneInString(text[:i])
+	base := i > 0 && unicode.In(r, unicode.L, unicode.N)
+	for _, r := range text[i:] {
+		switch {
+		case unicode.Is(unicode.M, r):
+			if !base || isVariationSelector(r) {
+				continue
+			}
+		default:
+			base = unicode.In(r, unicode.L, unicode.N)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// isVariationSelector reports whether the rune selects a glyph variant,
+// like U+FE0F selecting emoji presentation.
+func isVariationSelector(r rune) bool {
+	return r >= 0xFE00 && r <= 0xFE0F || r >= 0xE0100 && r <= 0xE01EF
+}
+
+// IsSlug returns True if provided text does not contain white characters,
+// punctuation, all letters are lower case and only from ASCII range.
+// It could contain the separator and `_` (unless underscores are converted
+// or removed) but not at the beginning or end of the text.
+// It should be in range of the Slugger MaxLength if specified.
+// In Unicode mode any lower case letters, numbers and combining marks are
+// allowed, see IsUnicodeSlug.
+// Config options are taken into account the same way as by Validate.
+func (s *Slugger) IsSlug(text string) bool {
+	return s.validate(text, s.cfg.Unicode) == nil
+}
+
+func copySub(sub map[string]string) map[string]string {
+	if sub == nil {
+		return nil
+	}
+	cp := make(map[string]string, len(sub))
+	for k, v := range sub {
+		cp[k] = v
+	}
+	return cp
+}
+
+func copyStopWords(stop map[string][]string) map[string][]string {
+	if stop == nil {
+		return nil
+	}
+	cp := make(map[string][]string, len(stop))
+	for k, v := range stop {
+		cp[k] = append([]string(nil), v...)
+	}
+	return cp
+}
+
+func copyRuneSub(sub map[rune]string) map[rune]string {
+	if sub == nil {
+		return nil
+	}
+	cp := make(map[rune]string, len(sub))
+	for k, v := range sub {
+		cp[k] = v
+	}
+	return cp
+}
@@ -0,0 +1,577 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+//=============================================================================
+
+func TestSluggerMakeLang(t *testing.T) {
+	lowerOff := DefaultConfig()
+	lowerOff.Lowercase = false
+	truncate := DefaultConfig()
+	truncate.MaxLength = 12
+	hardTruncate := DefaultConfig()
+	hardTruncate.MaxLength = 14
+	hardTruncate.EnableSmartTruncate = false
+	custom := DefaultConfig()
+	custom.CustomSub = map[string]string{"water": "sand"}
+	custom.CustomRuneSub = map[rune]string{'&': "or"}
+	keepDashes := DefaultConfig()
+	keepDashes.DisableMultipleDashTrim = true
+	keepDashes.DisableEndsTrim = true
+
+	testCases := []struct {
+		cfg  Config
+		lang string
+		in   string
+		want string
+	}{
+		{DefaultConfig(), "en", "Hellö Wörld хелло ворлд", "hello-world-khello-vorld"},
+		{DefaultConfig(), "de", "Diese & Dass", "diese-und-dass"},
+		{lowerOff, "de", "Diese & Dass", "Diese-und-Dass"},
+		{truncate, "en", "Dobroslaw Zybort", "dobroslaw"},
+		{hardTruncate, "en", "Long branch-name", "long-branch-na"},
+		{custom, "en", "water & fire", "sand-or-fire"},
+		{keepDashes, "en", "-test--slug-", "-test--slug-"},
+	}
+
+	for index, st := range testCases {
+		got := New(st.cfg).MakeLang(st.in, st.lang)
+		if got != st.want {
+			t.Errorf(
+				"%d. %#v; MakeLang(%#v, %#v) = %#v; want %#v",
+				index, st.cfg, st.in, st.lang, got, st.want)
+		}
+	}
+}
+
+func TestSluggerIgnoresGlobals(t *testing.T) {
+	MaxLength = 3
+	Lowercase = false
+	CustomSub = map[string]string{"hello": "bye"}
+	defer func() {
+		MaxLength = 0
+		Lowercase = true
+		CustomSub = nil
+	}()
+
+	s := New(DefaultConfig())
+	if got, want := s.Make("Hello World"), "hello-world"; got != want {
+		t.Errorf("Make(%#v) = %#v; want %#v", "Hello World", got, want)
+	}
+	if got, want := Make("hello World"), "bye"; got != want {
+		t.Errorf("Make(%#v) = %#v; want %#v", "hello World", got, want)
+	}
+}
+
+func TestSluggerCopiesConfig(t *testing.T) {
+	c := DefaultConfig()
+	c.CustomSub = map[string]string{"water": "sand"}
+	s := New(c)
+	c.CustomSub["water"] = "ice"
+
+	if got, want := s.Make("water"), "sand"; got != want {
+		t.Errorf("Make(%#v) = %#v; want %#v", "water", got, want)
+	}
+
+	got := s.Config()
+	got.CustomSub["water"] = "fire"
+	if got, want := s.Make("water"), "sand"; got != want {
+		t.Errorf("Make(%#v) = %#v; want %#v", "water", got, want)
+	}
+}
+
+func TestSluggerIsSlug(t *testing.T) {
+	c := DefaultConfig()
+	c.MaxLength = 4
+	s := New(c)
+
+	testCases := []struct {
+		in   string
+		want bool
+	}{
+		{"some", true},
+		{"some-more", false},
+		{"Some", false},
+		{"-so", false},
+		{"", false},
+	}
+
+	for index, st := range testCases {
+		if got := s.IsSlug(st.in); got != st.want {
+			t.Errorf("%d. IsSlug(%#v) = %v; want %v", index, st.in, got, st.want)
+		}
+	}
+}
+
+func TestSluggerConcurrent(t *testing.T) {
+	short := DefaultConfig()
+	short.MaxLength = 5
+	upper := DefaultConfig()
+	upper.Lowercase = false
+	sShort, sUpper := New(short), New(upper)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if got := sShort.Make("Hello World"); got != "hello" {
+				t.Errorf("Make() = %#v; want %#v", got, "hello")
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if got := sUpper.Make("Hello World"); got != "Hello-World" {
+				t.Errorf("Make() = %#v; want %#v", got, "Hello-World")
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestConfigure(t *testing.T) {
+	c := DefaultConfig()
+	c.MaxLength = 5
+	c.CustomSub = map[string]string{"water": "sand"}
+	Configure(c)
+	defer ResetConfig()
+
+	// Package level variables are ignored after Configure.
+	Lowercase = false
+	defer func() { Lowercase = true }()
+
+	testCases := []struct {
+		in   string
+		want string
+	}{
+		{"Hello World", "hello"},
+		{"Water", "water"},
+		{"water", "sand"},
+	}
+	for index, st := range testCases {
+		if got := Make(st.in); got != st.want {
+			t.Errorf("%d. Make(%#v) = %#v; want %#v", index, st.in, got, st.want)
+		}
+	}
+	if IsSlug("hello-world") {
+		t.Errorf("IsSlug(%#v) = true; want false", "hello-world")
+	}
+	if got := CurrentConfig().MaxLength; got != 5 {
+		t.Errorf("CurrentConfig().MaxLength = %v; want %v", got, 5)
+	}
+
+	ResetConfig()
+	if got, want := Make("Hello World"), "Hello-World"; got != want {
+		t.Errorf("Make(%#v) = %#v; want %#v", "Hello World", got, want)
+	}
+}
+
+func TestPackageVariablesCached(t *testing.T) {
+	defer func() {
+		MaxLength = 0
+		CustomSub = nil
+	}()
+
+	if got, want := Make("Hello World"), "hello-world"; got != want {
+		t.Errorf("Make(%#v) = %#v; want %#v", "Hello World", got, want)
+	}
+	// Changes of the package level variables are seen by the next call.
+	MaxLength = 5
+	if got, want := Make("Hello World"), "hello"; got != want {
+		t.Errorf("MaxLength = 5; Make(%#v) = %#v; want %#v", "Hello World", got, want)
+	}
+	MaxLength = 0
+	CustomSub = map[string]string{"World": "Earth"}
+	if got, want := Make("Hello World"), "hello-earth"; got != want {
+		t.Errorf("Make(%#v) = %#v; want %#v", "Hello World", got, want)
+	}
+	CustomSub["World"] = "Mars"
+	if got, want := Make("Hello World"), "hello-mars"; got != want {
+		t.Errorf("Make(%#v) = %#v; want %#v", "Hello World", got, want)
+	}
+	CustomSub = nil
+
+	// The package level functions allocate no more than a Slugger.
+	s := New(DefaultConfig())
+	want := testing.AllocsPerRun(100, func() { s.Make("Hello world") })
+	if got := testing.AllocsPerRun(100, func() { Make("Hello world") }); got > want {
+		t.Errorf("Make() allocs = %v; want %v", got, want)
+	}
+}
+
+func TestConfigureConcurrent(t *testing.T) {
+	defer ResetConfig()
+
+	short := DefaultConfig()
+	short.MaxLength = 5
+	short.CustomSub = map[string]string{"world": "earth"}
+	long := DefaultConfig()
+	long.CustomSub = map[string]string{"world": "earth"}
+	Configure(long)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				if (i+j)%2 == 0 {
+					Configure(short)
+				} else {
+					Configure(long)
+				}
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				got := Make("hello world")
+				if got != "hello" && got != "hello-earth" {
+					t.Errorf("Make() = %#v; want %#v or %#v", got, "hello", "hello-earth")
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestSluggerSeparator(t *testing.T) {
+	testCases := []struct {
+		sep        string
+		underscore UnderscoreMode
+		maxLength  int
+		in         string
+		want       string
+	}{
+		{"", UnderscoreKeep, 0, "Hello World test_slug", "hello-world-test_slug"},
+		{"-", UnderscoreToSeparator, 0, "Hello World test_slug", "hello-world-test-slug"},
+		{"-", UnderscoreRemove, 0, "Hello World test_slug", "hello-world-testslug"},
+		{"_", UnderscoreKeep, 0, "Hello World-Again", "hello_world_again"},
+		{"_", UnderscoreKeep, 0, "__Hello__ -World_", "hello_world"},
+		{"_", UnderscoreRemove, 0, "some_more words", "somemore_words"},
+		{".", UnderscoreToSeparator, 0, "app config_value", "app.config.value"},
+		{".", UnderscoreKeep, 0, ".Hello. -World..", "hello.world"},
+		{"::", UnderscoreToSeparator, 0, "Hello World: test_slug", "hello::world::test::slug"},
+		{"_", UnderscoreKeep, 12, "Dobroslaw Zybort", "dobroslaw"},
+		{"::", UnderscoreKeep, 10, "abc def ghi", "abc::def"},
+		{"::", UnderscoreKeep, 7, "abc def ghi", "abc"},
+	}
+
+	for index, st := range testCases {
+		c := DefaultConfig()
+		c.Separator = st.sep
+		c.Underscore = st.underscore
+		c.MaxLength = st.maxLength
+		s := New(c)
+		got := s.Make(st.in)
+		if got != st.want {
+			t.Errorf(
+				"%d. Separator = %#v; Make(%#v) = %#v; want %#v",
+				index, st.sep, st.in, got, st.want)
+		}
+		if !s.IsSlug(got) {
+			t.Errorf(
+				"%d. Separator = %#v; IsSlug(%#v) = false; want true",
+				index, st.sep, got)
+		}
+	}
+}
+
+func TestSluggerSeparatorIsSlug(t *testing.T) {
+	testCases := []struct {
+		sep        string
+		underscore UnderscoreMode
+		in         string
+		want       bool
+	}{
+		{"_", UnderscoreKeep, "some_more", true},
+		{"_", UnderscoreKeep, "some-more", false},
+		{"_", UnderscoreKeep, "_some", false},
+		{".", UnderscoreKeep, "some.more_words", true},
+		{".", UnderscoreToSeparator, "some.more_words", false},
+		{".", UnderscoreKeep, "some.", false},
+		{"::", UnderscoreKeep, "some::more", true},
+		{"::", UnderscoreKeep, "some:more", false},
+		{"::", UnderscoreKeep, "::some", false},
+	}
+
+	for index, st := range testCases {
+		c := DefaultConfig()
+		c.Separator = st.sep
+		c.Underscore = st.underscore
+		if got := New(c).IsSlug(st.in); got != st.want {
+			t.Errorf(
+				"%d. Separator = %#v; IsSlug(%#v) = %v; want %v",
+				index, st.sep, st.in, got, st.want)
+		}
+	}
+}
+
+func TestSluggerHashTruncate(t *testing.T) {
+	testCases := []struct {
+		maxLength     int
+		smartTruncate bool
+		length        int
+		alphabet      string
+		in            string
+		want          string
+	}{
+		{20, true, 0, "", "Short title", "short-title"},
+		{20, true, 0, "", "The best guide to the Alps", "the-best-8yiotiu5"},
+		{20, true, 0, "", "The best guide to the Pyrenees", "the-best-nv4z9z6i"},
+		{20, false, 0, "", "The best guide to the Alps", "the-best-gu-8yiotiu5"},
+		{20, true, 4, "", "The best guide to the Alps", "the-best-guide-8yio"},
+		{12, true, 4, "01", "The best guide to the Alps", "the-0000"},
+		{6, true, 0, "", "The best guide to the Alps", "8yioti"},
+	}
+
+	for index, st := range testCases {
+		c := DefaultConfig()
+		c.MaxLength = st.maxLength
+		c.EnableSmartTruncate = st.smartTruncate
+		c.HashTruncate = true
+		c.HashLength = st.length
+		c.HashAlphabet = st.alphabet
+		got := New(c).Make(st.in)
+		if got != st.want {
+			t.Errorf(
+				"%d. MaxLength = %v; Make(%#v) = %#v; want %#v",
+				index, st.maxLength, st.in, got, st.want)
+		}
+		if len(got) > st.maxLength {
+			t.Errorf(
+				"%d. MaxLength = %v; len(Make(%#v)) = %v",
+				index, st.maxLength, st.in, len(got))
+		}
+	}
+}
+
+func TestSluggerRemoveStopWords(t *testing.T) {
+	testCases := []struct {
+		lang      string
+		stopWords map[string][]string
+		maxLength int
+		keepDash  bool
+		in        string
+		want      string
+	}{
+		{"en", nil, 0, false, "The best guide to the Alps", "best-guide-alps"},
+		{"en", nil, 0, false, "To be or not to be", "to-be-or-not-to-be"},
+		{"en", nil, 0, false, "The The", "the-the"},
+		{"en", nil, 0, false, "Of Mice and Men", "mice-men"},
+		{"en", nil, 0, false, "Alps", "alps"},
+		{"en", nil, 15, false, "The best guide to the Alps", "best-guide-alps"},
+		{"de", nil, 0, false, "Der beste Führer für die Alpen", "beste-fuehrer-alpen"},
+		{"fr", nil, 0, false, "Le guide des Alpes", "guide-alpes"},
+		{"bg", nil, 0, false, "Пътеводител за Алпите и Пирин", "patevoditel-alpite-pirin"},
+		{"gr", nil, 0, false, "Ο οδηγός των Άλπεων", "odigos-alpeon"},
+		{"gr", nil, 0, false, "Ο οδηγός για τις Άλπεις", "o-odigos-gia-tis-alpeis"},
+		{"test", nil, 0, false, "The best guide to the Alps", "best-guide-alps"},
+		{"en", map[string][]string{"en": {"best"}}, 0, false, "The best guide to the Alps", "the-guide-to-the-alps"},
+		{"de", map[string][]string{"en": {"best"}}, 0, false, "Der beste Führer für die Alpen", "beste-fuehrer-alpen"},
+		{"de", map[string][]string{"de": {"Führer"}}, 0, false, "Der beste Führer", "der-beste"},
+		{"en", nil, 0, false, "Guide _ the", "guide"},
+		{"en", nil, 0, false, "The _ guide", "guide"},
+		{"en", nil, 0, true, "the -best guide", "best-guide"},
+		{"en", nil, 0, true, "best - the", "best"},
+		{"en", nil, 0, true, "the - - to best", "the-----to-best"},
+	}
+
+	for index, st := range testCases {
+		c := DefaultConfig()
+		c.RemoveStopWords = true
+		c.StopWords = st.stopWords
+		c.MaxLength = st.maxLength
+		c.DisableMultipleDashTrim = st.keepDash
+		s := New(c)
+		got := s.MakeLang(st.in, st.lang)
+		if got != st.want {
+			t.Errorf(
+				"%d. MakeLang(%#v, %#v) = %#v; want %#v",
+				index, st.in, st.lang, got, st.want)
+		}
+		if err := s.Validate(got); err != nil {
+			t.Errorf("%d. Validate(%#v) = %v; want nil", index, got, err)
+		}
+	}
+}
+
+func TestSluggerUnicode(t *testing.T) {
+	testCases := []struct {
+		lang      string
+		sep       string
+		stopWords bool
+		maxLength int
+		in        string
+		want      string
+	}{
+		{"en", "", false, 0, "東京 旅行", "東京-旅行"},
+		{"en", "", false, 0, "مرحبا بالعالم", "مرحبا-بالعالم"},
+		{"en", "", false, 0, "Привет, Мир!", "привет-мир"},
+		{"en", "", false, 0, "Straße", "strasse"},
+		{"en", "", false, 0, "STRASSE", "strasse"},
+		{"en", "", false, 0, "नमस्ते दुनिया", "नमस्ते-दुनिया"},
+		{"en", "", false, 0, "This & that", "this-and-that"},
+		{"de", "", false, 0, "Käse & Brot", "käse-und-brot"},
+		{"gr", "", false, 0, "Ο οδηγός & Άλπεις", "ο-οδηγόσ-kai-άλπεισ"},
+		{"en", "", false, 0, "Hello world_test", "hello-world_test"},
+		{"en", "_", false, 0, "東京 旅行", "東京_旅行"},
+		{"en", "", false, 7, "東京旅行", "東京"},
+		{"en", "", false, 8, "東京 旅行", "東京"},
+		{"de", "", true, 0, "Der beste Führer für die Alpen", "beste-führer-alpen"},
+		{"en", "", false, 0, "❤️ love", "love"},
+		{"en", "", false, 0, "Café ☕️ time", "café-time"},
+		{"en", "", false, 0, "Summer Sale 🔥🏖️", "summer-sale"},
+		{"en", "", false, 0, "Cafe\u0301 \u0301x", "cafe\u0301-x"},
+		{"en", "", false, 0, "नमस्ते ँ", "नमस्ते"},
+	}
+
+	for index, st := range testCases {
+		c := DefaultConfig()
+		c.Unicode = true
+		c.Separator = st.sep
+		c.RemoveStopWords = st.stopWords
+		c.MaxLength = st.maxLength
+		got := New(c).MakeLang(st.in, st.lang)
+		if got != st.want {
+			t.Errorf(
+				"%d. MakeLang(%#v, %#v) = %#v; want %#v",
+				index, st.in, st.lang, got, st.want)
+		}
+	}
+}
+
+func TestSluggerUnicodeIsSlug(t *testing.T) {
+	c := DefaultConfig()
+	c.Unicode = true
+	s := New(c)
+
+	testCases := []struct {
+		in   string
+		want bool
+	}{
+		{"東京-旅行", true},
+		{"käse-und-brot", true},
+		{"नमस्ते-दुनिया", true},
+		{"hello_world", true},
+		{"Käse", false},
+		{"strasse", true},
+		{"cafe\u0301", true},
+		{"\ufe0f-love", false},
+		{"café-\ufe0f-time", false},
+		{"love\ufe0f", false},
+		{"café-\u0301x", false},
+		{"a_\u0301", false},
+		{"straße", false},
+		{"οδηγός", false},
+		{"東京 旅行", false},
+		{"-東京", false},
+		{"東京!", false},
+	}
+
+	for index, st := range testCases {
+		if got := s.IsSlug(st.in); got != st.want {
+			t.Errorf("%d. IsSlug(%#v) = %#v; want %#v", index, st.in, got, st.want)
+		}
+		if got := IsUnicodeSlug(st.in); got != st.want {
+			t.Errorf("%d. IsUnicodeSlug(%#v) = %#v; want %#v", index, st.in, got, st.want)
+		}
+	}
+
+	if IsSlug("東京-旅行") {
+		t.Errorf("IsSlug(%#v) = true; want false", "東京-旅行")
+	}
+}
+
+func TestSluggerNormalization(t *testing.T) {
+	testCases := []struct {
+		form NormForm
+		lang string
+		in   string
+		want string
+	}{
+		{NormNone, "de", "Ka\u0308se", "kase"},
+		{NormNFC, "de", "Ka\u0308se", "kaese"},
+		{NormNFC, "de", "Käse", "kaese"},
+		{NormNFC, "en", "①②", ""},
+		{NormNFKC, "de", "Ka\u0308se", "kaese"},
+		{NormNFKC, "en", "ﬁle ①②", "file-12"},
+		{NormNFKC, "en", "Ｈｅｌｌｏ 𝐖𝐨𝐫𝐥𝐝", "hello-world"},
+		{NormNFKD, "de", "Käse", "kase"},
+		{NormNFKD, "en", "Ｃａｆé ①", "cafe-1"},
+	}
+
+	for index, st := range testCases {
+		c := DefaultConfig()
+		c.Normalization = st.form
+		got := New(c).MakeLang(st.in, st.lang)
+		if got != st.want {
+			t.Errorf(
+				"%d. Normalization = %v; MakeLang(%#v, %#v) = %#v; want %#v",
+				index, st.form, st.in, st.lang, got, st.want)
+		}
+	}
+
+	c := DefaultConfig()
+	c.Normalization = NormNFKD
+	c.RemoveStopWords = true
+	in := "Der beste Führer für die Alpen"
+	if got := New(c).MakeLang(in, "de"); got != "beste-fuhrer-alpen" {
+		t.Errorf("MakeLang(%#v, %#v) = %#v; want %#v", in, "de", got, "beste-fuhrer-alpen")
+	}
+}
+
+func TestSluggerFusedStages(t *testing.T) {
+	chars := []string{
+		"a", "Z", "7", " ", "  ", "\t", "-", "--", "_", ".", "&", "@", "\"", "'",
+		"!", "ä", "Ł", "東", "😀", "\u0301", "\xff", "\r\n",
+	}
+	configs := []func(c *Config){
+		func(c *Config) {},
+		func(c *Config) { c.Lowercase = false },
+		func(c *Config) { c.DisableMultipleDashTrim = true },
+		func(c *Config) { c.DisableEndsTrim = true },
+		func(c *Config) { c.DisableEndsTrim = true; c.DisableMultipleDashTrim = true },
+		func(c *Config) { c.Underscore = UnderscoreRemove },
+		func(c *Config) { c.Underscore = UnderscoreToSeparator },
+		func(c *Config) { c.Separator = "." },
+		func(c *Config) { c.MaxLength = 7 },
+		func(c *Config) { c.MaxLength = 7; c.EnableSmartTruncate = false },
+		func(c *Config) { c.MaxLength = 7; c.EnableSmartTruncate = false; c.LengthUnit = LengthGraphemes },
+		func(c *Config) { c.EnableSmartTruncate = false },
+		func(c *Config) { c.RemoveStopWords = true },
+		func(c *Config) { c.CustomRuneSub = map[rune]string{'a': "X"} },
+	}
+	rnd := rand.New(rand.NewSource(1))
+
+	for index, config := range configs {
+		c := DefaultConfig()
+		config(&c)
+		fused := New(c)
+		staged := New(c)
+		staged.fused = false
+		if !fused.fused {
+			t.Fatalf("%d. fused = false", index)
+		}
+		for i := 0; i < 500; i++ {
+			in := ""
+			for n := rnd.Intn(12); n >= 0; n-- {
+				in += chars[rnd.Intn(len(chars))]
+			}
+			lang := []string{"en", "de", "cs", "sv"}[i%4]
+			got, want := fused.MakeLang(in, lang), staged.MakeLang(in, lang)
+			if got != want {
+				t.Errorf("%d. MakeLang(%#v, %#v) = %#v; want %#v", index, in, lang, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,278 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/transform"
+)
+
+// streamChunkSize is the size of text made into slug at once by streams.
+// Text is cut after white space, so words are never split, unless there
+// is no white space in 4 chunks.
+const streamChunkSize = 4096
+
+// Transformer makes slug from text streamed through it. It implements
+// golang.org/x/text/transform.Transformer, so it can be used with
+// transform.NewReader or transform.NewWriter.
+//
+// Text is made into slug in chunks of a few kilobytes cut after white
+// space, so runes and words split between buffers are kept together.
+// Slugs of text shorter than a chunk are the same as made by MakeLang.
+// In longer text substitutions with white space in the key could miss
+// a match at the chunk boundary, and stop words are counted in each chunk
+// separately.
+//
+// Without MaxLength and Reserved the slug is written as chunks are made.
+// Otherwise it's written at the end, and once the slug is longer than
+// MaxLength the rest of the text is skipped; with HashTruncate the hash is
+// computed from the text read until then.
+type Transformer struct {
+	s *Slugger
+	l *resolvedLanguage
+
+	in   []byte // text not made into slug yet
+	base []byte // slug kept until the end
+	out  []byte // slug ready to be written
+	err  error
+
+	started  bool // some slug was already written to out
+	done     bool // slug is long enough, the rest of the text is skipped
+	finished bool
+}
+
+// NewTransformer returns Transformer making slugs with the package level
+// options and provided language. See Slugger.NewTransformer.
+func NewTransformer(lang string) *Transformer {
+	return globalSlugger().NewTransformer(lang)
+}
+
+// NewTransformer returns Transformer making slugs like MakeLang, using
+// provided language for chars substitution.
+func (s *Slugger) NewTransformer(lang string) *Transformer {
+	return &Transformer{s: s, l: s.language(lang)}
+}
+
+// Reset prepares Transformer for new text.
+func (t *Transformer) Reset() {
+	*t = Transformer{s: t.s, l: t.l, in: t.in[:0], base: t.base[:0], out: t.out[:0]}
+}
+
+// Transform implements transform.Transformer. It consumes all of src
+// and returns transform.ErrShortDst while the slug made doesn't fit in
+// dst. The slug isn't complete until Transform is called with atEOF.
+func (t *Transformer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	t.write(src)
+	nSrc = len(src)
+	if atEOF {
+		t.finish()
+	}
+	nDst = copy(dst, t.out)
+	t.out = t.out[:copy(t.out, t.out[nDst:])]
+	switch {
+	case len(t.out) > 0:
+		return nDst, nSrc, transform.ErrShortDst
+	case t.finished:
+		return nDst, nSrc, t.err
+	}
+	return nDst, nSrc, nil
+}
+
+// Done returns true if the slug reached MaxLength, so the rest of the text
+// is skipped.
+func (t *Transformer) Done() bool {
+	return t.done
+}
+
+// hold returns true if the slug is written only at the end.
+func (t *Transformer) hold() bool {
+	return t.s.cfg.MaxLength > 0 || t.s.reservedExact != nil || t.s.reservedPrefixes != nil
+}
+
+// write adds text to the slug, making it in chunks.
+func (t *Transformer) write(p []byte) {
+	if t.done || t.finished {
+		return
+	}
+	t.in = append(t.in, p...)
+	for !t.done && len(t.in) >= streamChunkSize {
+		i := chunkEnd(t.in)
+		if i <= 0 {
+			break
+		}
+		t.add(t.in[:i])
+		t.in = t.in[:copy(t.in, t.in[i:])]
+	}
+}
+
+// chunkEnd returns length of the chunk which can be made into slug, cut
+// after the last white space, or 0 if it's better to wait for more text.
+func chunkEnd(in []byte) int {
+	for i := len(in); i > 0; {
+		r, size := utf8.DecodeLastRune(in[:i])
+		if unicode.IsSpace(r) {
+			return i
+		}
+		i -= size
+	}
+	if len(in) < 4*streamChunkSize {
+		return 0
+	}
+	// One long word, cut it before the last rune, which may be incomplete.
+	i := len(in) - 1
+	for i > 0 && !utf8.RuneStart(in[i]) {
+		i--
+	}
+	return i
+}
+
+// add makes chunk into slug and appends it.
+func (t *Transformer) add(chunk []byte) {
+	s := t.s
+	piece := s.runStages(string(chunk), t.l)
+	if piece == "" {
+		return
+	}
+	if !t.hold() {
+		if t.started {
+			t.out = append(t.out, s.sep...)
+		}
+		t.out = append(t.out, piece...)
+		t.started = true
+		return
+	}
+
+	if len(t.base) > 0 {
+		t.base = append(t.base, s.sep...)
+	}
+	t.base = append(t.base, piece...)
+	c := &s.cfg
+	// Smart truncation looks for the separator right after MaxLength.
+	if c.MaxLength > 0 && textLength(string(t.base), c.LengthUnit) > c.MaxLength+textLength(s.sep, c.LengthUnit) {
+		t.done = true
+		t.finish()
+	}
+}
+
+// finish makes the rest of the text into slug and appends suffixes.
+func (t *Transformer) finish() {
+	if t.finished {
+		return
+	}
+	if len(t.in) > 0 && !t.done {
+		in := t.in
+		t.in = t.in[:0]
+		t.add(in)
+		if t.finished {
+			return
+		}
+	}
+	t.finished = true
+
+	s := t.s
+	suffixes := s.suffixes()
+	switch {
+	case t.hold() || !t.started:
+		var slug string
+		slug, t.err = s.finish(string(t.base), suffixes)
+		t.out = append(t.out, slug...)
+	case len(suffixes) > 0:
+		t.out = append(t.out, s.sep...)
+		t.out = append(t.out, strings.Join(suffixes, s.sep)...)
+	}
+}
+
+// Writer writes slug of text written to it to another writer, see
+// Transformer. Close must be called to write the end of the slug.
+type Writer struct {
+	t *Transformer
+	w io.Writer
+}
+
+// NewWriter returns Writer making slugs with the package level options
+// and provided language.
+func NewWriter(w io.Writer, lang string) *Writer {
+	return globalSlugger().NewWriter(w, lang)
+}
+
+// NewWriter returns Writer writing to w slug made like MakeLang, using
+// provided language for chars substitution.
+func (s *Slugger) NewWriter(w io.Writer, lang string) *Writer {
+	return &Writer{t: s.NewTransformer(lang), w: w}
+}
+
+// Write adds p to the text. Once Done returns true, text is skipped.
+func (w *Writer) Write(p []byte) (int, error) {
+	w.t.write(p)
+	return len(p), w.flush()
+}
+
+// Close writes the end of the slug. It returns *ValidationError if the
+// slug is reserved and can't be rewritten.
+func (w *Writer) Close() error {
+	w.t.finish()
+	if err := w.flush(); err != nil {
+		return err
+	}
+	return w.t.err
+}
+
+// Done returns true if the slug reached MaxLength, so the rest of the text
+// is skipped.
+func (w *Writer) Done() bool {
+	return w.t.done
+}
+
+func (w *Writer) flush() error {
+	if len(w.t.out) == 0 {
+		return nil
+	}
+	_, err := w.w.Write(w.t.out)
+	w.t.out = w.t.out[:0]
+	return err
+}
+
+// MakeReader returns slug generated from text read from r. Will use "en"
+// as language substitution. See Slugger.MakeReaderLang.
+func MakeReader(r io.Reader) (string, error) {
+	return globalSlugger().MakeReaderLang(r, "en")
+}
+
+// MakeReaderLang returns slug generated from text read from r and will use
+// provided language for chars substitution.
+func MakeReaderLang(r io.Reader, lang string) (string, error) {
+	return globalSlugger().MakeReaderLang(r, lang)
+}
+
+// MakeReader returns slug generated from text read from r. Will use "en"
+// as language substitution.
+func (s *Slugger) MakeReader(r io.Reader) (string, error) {
+	return s.MakeReaderLang(r, "en")
+}
+
+// MakeReaderLang returns slug generated from text read from r, see
+// Transformer. Reading stops once the slug reached MaxLength, so only the
+// beginning of large documents is read.
+func (s *Slugger) MakeReaderLang(r io.Reader, lang string) (string, error) {
+	t := s.NewTransformer(lang)
+	buf := make([]byte, streamChunkSize)
+	for !t.done {
+		n, err := r.Read(buf)
+		t.write(buf[:n])
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	t.finish()
+	return string(t.out), t.err
+}
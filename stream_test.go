@@ -0,0 +1,168 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/text/transform"
+)
+
+//=============================================================================
+
+func TestTransformer(t *testing.T) {
+	long := strings.Repeat("Žluťoučký kůň úpěl ďábelské ódy & ", 500)
+
+	testCases := []struct {
+		in     string
+		lang   string
+		config func(c *Config)
+	}{
+		{"", "en", nil},
+		{"Hello World", "en", nil},
+		{"  Příliš žluťoučký kůň  ", "cs", nil},
+		{"Äpfel & Birnen", "de", nil},
+		{long, "cs", nil},
+		{long, "cs", func(c *Config) { c.MaxLength = 50 }},
+		{long, "cs", func(c *Config) { c.MaxLength = 50; c.EnableSmartTruncate = false }},
+		{long, "cs", func(c *Config) { c.Unicode = true; c.Separator = "_" }},
+		{"Hello World", "en", func(c *Config) { c.AppendTimestamp = true }},
+		{"!!!", "en", func(c *Config) { c.AppendTimestamp = true }},
+		{long, "en", func(c *Config) { c.AppendTimestamp = true }},
+		{long, "en", func(c *Config) { c.AppendTimestamp = true; c.MaxLength = 30 }},
+		{"Admin", "en", func(c *Config) { c.Reserved = []string{"admin"} }},
+	}
+
+	for index, st := range testCases {
+		c := DefaultConfig()
+		c.Clock = ClockFunc(func() time.Time { return time.Unix(1792268130, 0) })
+		if st.config != nil {
+			st.config(&c)
+		}
+		s := New(c)
+		want := s.MakeLang(st.in, st.lang)
+
+		got, _, err := transform.String(s.NewTransformer(st.lang), st.in)
+		if got != want || err != nil {
+			t.Errorf("%d. transform.String() = %#v, %v; want %#v", index, got, err, want)
+		}
+
+		// Write one byte at a time, splitting runes.
+		var buf bytes.Buffer
+		w := s.NewWriter(&buf, st.lang)
+		for i := 0; i < len(st.in); i++ {
+			if _, err := w.Write([]byte{st.in[i]}); err != nil {
+				t.Fatalf("%d. Write() error = %v", index, err)
+			}
+		}
+		if err := w.Close(); buf.String() != want || err != nil {
+			t.Errorf("%d. Writer = %#v, %v; want %#v", index, buf.String(), err, want)
+		}
+
+		got, err = s.MakeReaderLang(strings.NewReader(st.in), st.lang)
+		if got != want || err != nil {
+			t.Errorf("%d. MakeReaderLang() = %#v, %v; want %#v", index, got, err, want)
+		}
+	}
+}
+
+func TestTransformerReader(t *testing.T) {
+	in := strings.Repeat("Hello World ", 2000)
+	r := transform.NewReader(strings.NewReader(in), NewTransformer("en"))
+	got, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	if want := Make(in); string(got) != want {
+		t.Errorf("transform.NewReader() = %d bytes; want %d", len(got), len(want))
+	}
+
+	// Without MaxLength the slug is written before Close.
+	var buf bytes.Buffer
+	w := NewWriter(&buf, "en")
+	w.Write([]byte(in))
+	if buf.Len() == 0 {
+		t.Errorf("Writer wrote nothing before Close")
+	}
+}
+
+// countingReader counts bytes read.
+type countingReader struct {
+	r io.Reader
+	n int
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += n
+	return n, err
+}
+
+func TestMakeReaderStops(t *testing.T) {
+	c := DefaultConfig()
+	c.MaxLength = 20
+	s := New(c)
+
+	r := &countingReader{r: io.LimitReader(repeatReader("hello world "), 1<<24)}
+	got, err := s.MakeReader(r)
+	if want := "hello-world-hello"; got != want || err != nil {
+		t.Errorf("MakeReader() = %#v, %v; want %#v", got, err, want)
+	}
+	if r.n > 4*streamChunkSize {
+		t.Errorf("MakeReader() read %d bytes; want at most %d", r.n, 4*streamChunkSize)
+	}
+
+	var buf bytes.Buffer
+	w := s.NewWriter(&buf, "en")
+	for i := 0; i < 1000 && !w.Done(); i++ {
+		w.Write([]byte("hello world "))
+	}
+	if !w.Done() {
+		t.Errorf("Writer.Done() = false")
+	}
+	if err := w.Close(); buf.String() != "hello-world-hello" || err != nil {
+		t.Errorf("Writer = %#v, %v; want %#v", buf.String(), err, "hello-world-hello")
+	}
+}
+
+func TestMakeReaderErrors(t *testing.T) {
+	c := DefaultConfig()
+	c.Reserved = []string{"admin"}
+	c.ReservedMode = ReservedReject
+	if _, err := New(c).MakeReader(strings.NewReader("Admin")); !errors.Is(err, ErrReserved) {
+		t.Errorf("MakeReader() error = %v; want %v", err, ErrReserved)
+	}
+
+	readErr := errors.New("read failed")
+	r := io.MultiReader(strings.NewReader("Hello"), errorReader{readErr})
+	if _, err := MakeReader(r); err != readErr {
+		t.Errorf("MakeReader() error = %v; want %v", err, readErr)
+	}
+}
+
+type repeatReader string
+
+func (r repeatReader) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		n += copy(p[n:], r)
+	}
+	return n, nil
+}
+
+type errorReader struct {
+	err error
+}
+
+func (e errorReader) Read(p []byte) (int, error) {
+	return 0, e.err
+}
@@ -0,0 +1,94 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// WithSymbols sets symbol vocabulary of the language used with
+// Config.Symbols. Keys are symbols like "%", "€" or "°C", or upper case
+// ISO 4217 currency codes like "EUR", values are words written as in the
+// text before transliteration. Symbols missing in the map are named as in
+// the parent language or in English.
+func WithSymbols(words map[string]string) LanguageOption {
+	return func(l *language) {
+		l.symbols = copySub(words)
+	}
+}
+
+// substituteSymbols replaces symbols in the text with words from the
+// vocabulary, surrounded by spaces, so "5€" becomes "5 euro ".
+// Currency codes are replaced only next to a number, e.g. "EUR 5",
+// so they don't change words like "USA".
+func substituteSymbols(text string, words map[string]string) string {
+	var b strings.Builder
+	last := 0
+	for i := 0; i < len(text); {
+		r, n := utf8.DecodeRuneInString(text[i:])
+		switch {
+		case r == '°':
+			// Temperature units, e.g. "°C".
+			if i+n < len(text) && (text[i+n] == 'C' || text[i+n] == 'F') &&
+				!startsWithLetter(text[i+n+1:]) {
+				n++
+			}
+		case r >= 'A' && r <= 'Z':
+			j := i
+			for j < len(text) && text[j] >= 'A' && text[j] <= 'Z' {
+				j++
+			}
+			if j-i != 3 || endsWithLetter(text[:i]) || startsWithLetter(text[j:]) ||
+				!nextToDigit(text, i, j) {
+				i = j
+				continue
+			}
+			n = 3
+		}
+
+		word, ok := words[text[i:i+n]]
+		if !ok {
+			i += n
+			continue
+		}
+		b.WriteString(text[last:i])
+		b.WriteByte(' ')
+		b.WriteString(word)
+		b.WriteByte(' ')
+		i += n
+		last = i
+	}
+	if last == 0 {
+		return text
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+// nextToDigit reports if text[i:j] is preceded or followed by a digit,
+// ignoring spaces between them.
+func nextToDigit(text string, i, j int) bool {
+	before := strings.TrimRight(text[:i], " ")
+	after := strings.TrimLeft(text[j:], " ")
+	return before != "" && isDigit(before[len(before)-1]) ||
+		after != "" && isDigit(after[0])
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func startsWithLetter(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return unicode.IsLetter(r)
+}
+
+func endsWithLetter(s string) bool {
+	r, _ := utf8.DecodeLastRuneInString(s)
+	return unicode.IsLetter(r)
+}
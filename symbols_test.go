@@ -0,0 +1,79 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"testing"
+)
+
+//=============================================================================
+
+func TestSlugMakeSymbols(t *testing.T) {
+	testCases := []struct {
+		lang string
+		in   string
+		want string
+	}{
+		{"en", "100% Cotton", "100-percent-cotton"},
+		{"de", "100% Baumwolle", "100-prozent-baumwolle"},
+		{"fr", "100% coton", "100-pour-cent-coton"},
+		{"en", "C + D", "c-plus-d"},
+		{"en", "C++", "c-plus-plus"},
+		{"en", "a=b", "a-equals-b"},
+		{"en", "€5 deal", "euro-5-deal"},
+		{"en", "5€", "5-euro"},
+		{"en", "€", "euro"},
+		{"tr", "5€", "5-avro"},
+		{"en", "Price: $10", "price-dollar-10"},
+		{"en", "24°C", "24-degrees-celsius"},
+		{"de", "24°C", "24-grad-celsius"},
+		{"en", "20 °F", "20-degrees-fahrenheit"},
+		{"en", "90°", "90-degrees"},
+		{"en", "© 2026", "copyright-2026"},
+		{"en", "Brand™", "brand-trademark"},
+		{"en", "1½ cups", "1-one-half-cups"},
+		{"en", "5 EUR", "5-euro"},
+		{"en", "EUR 5", "euro-5"},
+		{"fr", "USD100", "dollar-americain-100"},
+		{"en", "EUR policy", "eur-policy"},
+		{"en", "USA 2026", "usa-2026"},
+		{"en", "EURO 2024", "euro-2024"},
+		{"nn", "50%", "50-prosent"},
+		{"pt-br", "R$ 10 BRL", "r-dolar-10-real"},
+		{"kk", "100%", "100-paiyz"},
+		{"kk", "500₸", "500-tenge"},
+		{"id", "Diskon 50%", "diskon-50-persen"},
+		{"id", "IDR 5000", "rupiah-5000"},
+		{"sl", "5 % rabatt", "5-odstotkov-rabatt"},
+		{"sl", "10 €", "10-evro"},
+	}
+
+	for index, st := range testCases {
+		c := DefaultConfig()
+		c.Symbols = true
+		got := New(c).MakeLang(st.in, st.lang)
+		if got != st.want {
+			t.Errorf(
+				"%d. MakeLang(%#v, %#v) = %#v; want %#v",
+				index, st.in, st.lang, got, st.want)
+		}
+	}
+
+	// Symbols are off by default.
+	if got := Make("100% Cotton"); got != "100-cotton" {
+		t.Errorf("Make(%#v) = %#v; want %#v", "100% Cotton", got, "100-cotton")
+	}
+}
+
+func TestRegisterLanguageSymbols(t *testing.T) {
+	RegisterLanguage([]string{"x-symbols"}, nil, WithSymbols(map[string]string{"%": "pct"}))
+
+	c := DefaultConfig()
+	c.Symbols = true
+	if got := New(c).MakeLang("5% + 5€", "x-symbols"); got != "5-pct-plus-5-euro" {
+		t.Errorf("MakeLang(%#v, %#v) = %#v; want %#v", "5% + 5€", "x-symbols", got, "5-pct-plus-5-euro")
+	}
+}
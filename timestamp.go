@@ -0,0 +1,134 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"crypto/rand"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Clock provides current time. It allows to use fixed time in tests.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc adapts ordinary function to Clock.
+type ClockFunc func() time.Time
+
+// Now returns f().
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// systemClock returns time.Now.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// TimestampFunc formats time appended to the slug when AppendTimestamp is
+// set. Chars other than ASCII letters and digits are replaced with the
+// separator.
+type TimestampFunc func(t time.Time) string
+
+// TimestampUnix formats time as Unix seconds, e.g. "1792268100".
+// This is the default format.
+func TimestampUnix(t time.Time) string {
+	return strconv.FormatInt(t.Unix(), 10)
+}
+
+// TimestampUnixMilli formats time as Unix milliseconds.
+func TimestampUnixMilli(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano()/int64(time.Millisecond), 10)
+}
+
+// TimestampUnixNano formats time as Unix nanoseconds.
+func TimestampUnixNano(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+// TimestampDate formats UTC time as date, e.g. "20261017".
+func TimestampDate(t time.Time) string {
+	return t.UTC().Format("20060102")
+}
+
+// TimestampDateTime formats UTC time as date with hours and minutes,
+// e.g. "20261017-2015".
+func TimestampDateTime(t time.Time) string {
+	return t.UTC().Format("20060102-1504")
+}
+
+// TimestampLayout returns TimestampFunc formatting UTC time with provided
+// time.Format layout.
+func TimestampLayout(layout string) TimestampFunc {
+	return func(t time.Time) string {
+		return t.UTC().Format(layout)
+	}
+}
+
+// crockford is the ULID alphabet, lower cased to fit into slugs.
+const crockford = "0123456789abcdefghjkmnpqrstvwxyz"
+
+// TimestampULID returns TimestampFunc formatting time as ULID: 26 chars,
+// where the first 10 encode milliseconds, so slugs sort by time, and the
+// rest is random data read from entropy. If entropy is nil, or reading
+// from it fails, crypto/rand is used.
+func TimestampULID(entropy io.Reader) TimestampFunc {
+	if entropy == nil {
+		entropy = rand.Reader
+	}
+	return func(t time.Time) string {
+		var buf [26]byte
+		ms := uint64(t.UnixNano() / int64(time.Millisecond))
+		for i := 9; i >= 0; i-- {
+			buf[i] = crockford[ms&31]
+			ms >>= 5
+		}
+
+		var rnd [10]byte
+		if _, err := io.ReadFull(entropy, rnd[:]); err != nil {
+			if _, err := io.ReadFull(rand.Reader, rnd[:]); err != nil {
+				panic("slug: reading ULID entropy: " + err.Error())
+			}
+		}
+		// 80 random bits give exactly 16 chars of 5 bits.
+		var acc, bits uint
+		i := 10
+		for _, b := range rnd {
+			acc = acc<<8 | uint(b)
+			bits += 8
+			for bits >= 5 {
+				bits -= 5
+				buf[i] = crockford[(acc>>bits)&31]
+				i++
+			}
+		}
+		return string(buf[:])
+	}
+}
+
+// timestamp returns current time formatted as configured.
+func (s *Slugger) timestamp() string {
+	clock := s.cfg.Clock
+	if clock == nil {
+		clock = systemClock{}
+	}
+	format := s.cfg.TimestampFormat
+	if format == nil {
+		format = TimestampUnix
+	}
+	ts := format(clock.Now())
+	if s.cfg.Lowercase {
+		ts = strings.ToLower(ts)
+	}
+	ts = s.regexpNonAuthorized.ReplaceAllString(ts, s.sep)
+	ts = s.regexpMultipleSeps.ReplaceAllString(ts, s.sep)
+	return s.trimEnds(ts)
+}
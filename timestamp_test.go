@@ -0,0 +1,153 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"regexp"
+	"testing"
+	"time"
+)
+
+//=============================================================================
+
+func TestSlugMakeTimestampFormat(t *testing.T) {
+	now := time.Date(2026, 10, 17, 20, 15, 30, 123456789, time.UTC)
+	clock := ClockFunc(func() time.Time { return now })
+	entropy := bytes.NewReader(bytes.Repeat([]byte{0xff}, 10))
+
+	testCases := []struct {
+		format TimestampFunc
+		sep    string
+		want   string
+	}{
+		{nil, "", "hello-world-1792268130"},
+		{TimestampUnix, "", "hello-world-1792268130"},
+		{TimestampUnixMilli, "", "hello-world-1792268130123"},
+		{TimestampUnixNano, "", "hello-world-1792268130123456789"},
+		{TimestampDate, "", "hello-world-20261017"},
+		{TimestampDateTime, "", "hello-world-20261017-2015"},
+		{TimestampDateTime, "_", "hello_world_20261017_2015"},
+		{TimestampLayout(time.RFC3339), "", "hello-world-2026-10-17t20-15-30z"},
+		{TimestampULID(entropy), "", "hello-world-01m55r2ntbzzzzzzzzzzzzzzzz"},
+		{func(t time.Time) string { return "v" + t.Format("06") }, "", "hello-world-v26"},
+	}
+
+	for index, st := range testCases {
+		c := DefaultConfig()
+		c.AppendTimestamp = true
+		c.TimestampFormat = st.format
+		c.Separator = st.sep
+		c.Clock = clock
+		got := New(c).Make("Hello World")
+		if got != st.want {
+			t.Errorf("%d. Make(%#v) = %#v; want %#v", index, "Hello World", got, st.want)
+		}
+	}
+}
+
+func TestTimestampULID(t *testing.T) {
+	ulid := TimestampULID(nil)
+	want := regexp.MustCompile(`^[0-9a-hjkmnp-tv-z]{26}$`)
+
+	early := ulid(time.Unix(1000, 0))
+	late := ulid(time.Unix(2000, 0))
+	if !want.MatchString(early) || !want.MatchString(late) {
+		t.Errorf("TimestampULID() = %#v, %#v; want %v", early, late, want)
+	}
+	if early >= late {
+		t.Errorf("TimestampULID() = %#v >= %#v; want sorted by time", early, late)
+	}
+
+	// Failing entropy falls back to crypto/rand.
+	failing := TimestampULID(errorReader{io.ErrUnexpectedEOF})
+	if got := failing(time.Unix(1000, 0)); !want.MatchString(got) || got[:10] != early[:10] {
+		t.Errorf("TimestampULID(failing reader) = %#v; want %v", got, want)
+	}
+}
+
+func TestSlugMakeTimestampMaxLength(t *testing.T) {
+	now := time.Date(2026, 10, 17, 20, 15, 30, 0, time.UTC)
+	clock := ClockFunc(func() time.Time { return now })
+
+	testCases := []struct {
+		maxLength int
+		smart     bool
+		hash      bool
+		in        string
+		want      string
+	}{
+		{0, true, false, "Hello World", "hello-world-1792268130"},
+		{22, true, false, "Hello World", "hello-world-1792268130"},
+		{21, true, false, "Hello World", "hello-1792268130"},
+		{16, true, false, "Hello World", "hello-1792268130"},
+		{15, true, false, "Hello World", "hell-1792268130"},
+		{14, false, false, "Hello World", "hel-1792268130"},
+		{13, false, false, "Hello World", "he-1792268130"},
+		{11, true, false, "Hello World", "1792268130"},
+		{8, true, false, "Hello World", "17922681"},
+		{30, true, true, "The quick brown fox jumps", "the-quick-vli3f0zu-1792268130"},
+	}
+
+	for index, st := range testCases {
+		c := DefaultConfig()
+		c.AppendTimestamp = true
+		c.Clock = clock
+		c.MaxLength = st.maxLength
+		c.EnableSmartTruncate = st.smart
+		c.HashTruncate = st.hash
+		s := New(c)
+		got := s.Make(st.in)
+		if got != st.want {
+			t.Errorf(
+				"%d. MaxLength = %v; Make(%#v) = %#v; want %#v",
+				index, st.maxLength, st.in, got, st.want)
+		}
+		if !s.IsSlug(got) {
+			t.Errorf("%d. MaxLength = %v; IsSlug(%#v) = false", index, st.maxLength, got)
+		}
+	}
+}
+
+func TestSluggerMakeTimestampOnly(t *testing.T) {
+	now := time.Date(2026, 10, 17, 20, 15, 30, 0, time.UTC)
+	clock := ClockFunc(func() time.Time { return now })
+
+	testCases := []struct {
+		config func(c *Config)
+		want   string
+	}{
+		{func(c *Config) {}, "1792268130"},
+		{func(c *Config) { c.Unicode = true }, "1792268130"},
+		{func(c *Config) { c.Separator = "·" }, "1792268130"},
+		{func(c *Config) { c.Separator = "_"; c.MaxLength = 8 }, "17922681"},
+	}
+
+	for index, st := range testCases {
+		c := DefaultConfig()
+		c.AppendTimestamp = true
+		c.Clock = clock
+		st.config(&c)
+		s := New(c)
+		for _, in := range []string{"", "!!!"} {
+			got := s.Make(in)
+			if got != st.want {
+				t.Errorf("%d. Make(%#v) = %#v; want %#v", index, in, got, st.want)
+			}
+			if err := s.Validate(got); err != nil {
+				t.Errorf("%d. Validate(%#v) = %v; want nil", index, got, err)
+			}
+			if got, _ := s.MakePath(in, "/"); got != st.want {
+				t.Errorf("%d. MakePath(%#v) = %#v; want %#v", index, in, got, st.want)
+			}
+			if got, err := s.MakeUnique(context.Background(), in, NewMemoryStore()); got != st.want || err != nil {
+				t.Errorf("%d. MakeUnique(%#v) = %#v, %v; want %#v", index, in, got, err, st.want)
+			}
+		}
+	}
+}
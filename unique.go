@@ -0,0 +1,248 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"strconv"
+	"sync"
+)
+
+// ErrNoUniqueSlug is returned by MakeUnique when no free slug was found
+// within the allowed number of attempts.
+var ErrNoUniqueSlug = errors.New("slug: no unique slug found")
+
+// defaultUniqueMaxAttempts is used when Config.UniqueMaxAttempts is not set.
+const defaultUniqueMaxAttempts = 100
+
+// base36 is the alphabet used by random and hash suffixes.
+const base36 = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// Store reports which slugs are already in use.
+type Store interface {
+	// Exists returns true if slug is already taken.
+	Exists(ctx context.Context, slug string) (bool, error)
+}
+
+// Reserver is implemented by stores able to check and take a slug in one
+// atomic operation. MakeUnique prefers Reserve over Exists, so two
+// concurrent calls never return the same slug.
+type Reserver interface {
+	// Reserve takes slug if it's free. It returns false if slug is already
+	// taken.
+	Reserve(ctx context.Context, slug string) (bool, error)
+}
+
+// SuffixFunc returns suffix appended to the slug by MakeUnique when the slug
+// is already taken. Attempt starts from 1.
+type SuffixFunc func(slug string, attempt int) string
+
+// CounterSuffix appends incrementing counter: "title-2", "title-3", ...
+func CounterSuffix(slug string, attempt int) string {
+	return strconv.Itoa(attempt + 1)
+}
+
+// RandomSuffix returns SuffixFunc appending random base36 token of length n.
+// It panics if n is not positive.
+func RandomSuffix(n int) SuffixFunc {
+	checkSuffixLength("RandomSuffix", n)
+	return func(slug string, attempt int) string {
+		return randomString(n, base36)
+	}
+}
+
+// HashSuffix returns SuffixFunc appending base36 hash of length n computed
+// from the slug and the attempt number, so the same input always gives
+// the same sequence of candidates. It panics if n is not positive.
+func HashSuffix(n int) SuffixFunc {
+	checkSuffixLength("HashSuffix", n)
+	return func(slug string, attempt int) string {
+		return hashString(slug+"\x00"+strconv.Itoa(attempt), n, base36)
+	}
+}
+
+// checkSuffixLength panics if n is not positive, as empty suffixes would
+// give the same candidate on every attempt.
+func checkSuffixLength(name string, n int) {
+	if n <= 0 {
+		panic("slug: " + name + " called with length " + strconv.Itoa(n))
+	}
+}
+
+type scopeKey struct{}
+
+// WithScope returns context limiting uniqueness to provided scope, for
+// example tenant or parent ID. Stores use ScopeFromContext to keep slugs of
+// different scopes apart.
+func WithScope(ctx context.Context, scope string) context.Context {
+	return context.WithValue(ctx, scopeKey{}, scope)
+}
+
+// ScopeFromContext returns scope set with WithScope, or empty string.
+func ScopeFromContext(ctx context.Context) string {
+	scope, _ := ctx.Value(scopeKey{}).(string)
+	return scope
+}
+
+// MakeUnique returns slug generated from provided string which isn't
+// present in store yet. Will use "en" as language substitution.
+func MakeUnique(ctx context.Context, s string, store Store) (string, error) {
+	return globalSlugger().MakeUniqueLang(ctx, s, "en", store)
+}
+
+// MakeUniqueLang returns slug generated from provided string which isn't
+// present in store yet. Will use provided language for chars substitution.
+func MakeUniqueLang(ctx context.Context, s string, lang string, store Store) (string, error) {
+	return globalSlugger().MakeUniqueLang(ctx, s, lang, store)
+}
+
+// MakeUnique returns slug generated from provided string which isn't
+// present in store yet. Will use "en" as language substitution.
+func (s *Slugger) MakeUnique(ctx context.Context, text string, store Store) (string, error) {
+	return s.MakeUniqueLang(ctx, text, "en", store)
+}
+
+// MakeUniqueLang returns slug generated from provided string which isn't
+// present in store yet. When the slug is taken, suffixes from
+// Config.UniqueSuffix are tried, shortening the slug so the result always
+// fits in MaxLength. Reserved slugs are rewritten as set by ReservedMode,
+// or skipped with ReservedReject. If store implements Reserver the
+// returned slug is already reserved.
+func (s *Slugger) MakeUniqueLang(ctx context.Context, text string, lang string, store Store) (string, error) {
+	suffix := s.cfg.UniqueSuffix
+	if suffix == nil {
+		suffix = CounterSuffix
+	}
+	maxAttempts := s.cfg.UniqueMaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultUniqueMaxAttempts
+	}
+
+	base, suffixes := s.makeParts(text, s.language(lang))
+	slug := s.fit(base, suffixes...)
+	for attempt := 0; attempt <= maxAttempts; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		candidate, attemptSuffixes := slug, suffixes
+		if attempt > 0 {
+			attemptSuffixes = append(suffixes, suffix(slug, attempt))
+			candidate = s.fit(base, attemptSuffixes...)
+		}
+		candidate, err := s.avoidReserved(candidate, base, attemptSuffixes)
+		if err != nil {
+			// Rejected reserved slugs are treated as taken.
+			continue
+		}
+		ok, err := take(ctx, store, candidate)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return candidate, nil
+		}
+	}
+	return "", ErrNoUniqueSlug
+}
+
+// take reserves slug in store, or only checks it if store can't reserve.
+func take(ctx context.Context, store Store, slug string) (bool, error) {
+	if r, ok := store.(Reserver); ok {
+		return r.Reserve(ctx, slug)
+	}
+	exists, err := store.Exists(ctx, slug)
+	return !exists, err
+}
+
+// MemoryStore is in-memory Store and Reserver. Slugs are kept separately
+// for every scope set with WithScope.
+// The zero value is an empty store ready to use.
+// MemoryStore is safe for concurrent use.
+type MemoryStore struct {
+	mu    sync.Mutex
+	slugs map[string]map[string]struct{}
+}
+
+// NewMemoryStore returns empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+// Exists returns true if slug is taken in the context scope.
+func (m *MemoryStore) Exists(ctx context.Context, slug string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.slugs[ScopeFromContext(ctx)][slug]
+	return ok, nil
+}
+
+// Reserve takes slug in the context scope if it's free.
+func (m *MemoryStore) Reserve(ctx context.Context, slug string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	scope := ScopeFromContext(ctx)
+	if _, ok := m.slugs[scope][slug]; ok {
+		return false, nil
+	}
+	if m.slugs == nil {
+		m.slugs = make(map[string]map[string]struct{})
+	}
+	if m.slugs[scope] == nil {
+		m.slugs[scope] = make(map[string]struct{})
+	}
+	m.slugs[scope][slug] = struct{}{}
+	return true, nil
+}
+
+// Release frees slug in the context scope.
+func (m *MemoryStore) Release(ctx context.Context, slug string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.slugs[ScopeFromContext(ctx)], slug)
+	return nil
+}
+
+// hashString returns n chars long SHA-256 hash of s encoded with alphabet.
+func hashString(s string, n int, alphabet string) string {
+	sum := sha256.Sum256([]byte(s))
+	num := sum[:]
+	base := len(alphabet)
+	buf := make([]byte, n)
+	for i := range buf {
+		// Divide the big-endian number in num by base, keeping remainder.
+		rem := 0
+		for j, b := range num {
+			acc := rem<<8 | int(b)
+			num[j] = byte(acc / base)
+			rem = acc % base
+		}
+		buf[i] = alphabet[rem]
+	}
+	return string(buf)
+}
+
+// randomString returns n chars long random string from alphabet.
+func randomString(n int, alphabet string) string {
+	// Reject bytes above the largest multiple of alphabet length, so every
+	// char has the same probability.
+	limit := 256 - 256%len(alphabet)
+	buf := make([]byte, 0, n)
+	rnd := make([]byte, n)
+	for len(buf) < n {
+		if _, err := rand.Read(rnd); err != nil {
+			panic("slug: reading random bytes: " + err.Error())
+		}
+		for _, b := range rnd {
+			if int(b) < limit && len(buf) < n {
+				buf = append(buf, alphabet[int(b)%len(alphabet)])
+			}
+		}
+	}
+	return string(buf)
+}
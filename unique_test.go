@@ -0,0 +1,264 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+)
+
+//=============================================================================
+
+func TestMakeUniqueCounter(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+
+	want := []string{"hello-world", "hello-world-2", "hello-world-3", "hello-world-4"}
+	for index, w := range want {
+		got, err := MakeUnique(ctx, "Hello World", store)
+		if err != nil {
+			t.Fatalf("%d. MakeUnique() error = %v", index, err)
+		}
+		if got != w {
+			t.Errorf("%d. MakeUnique(%#v) = %#v; want %#v", index, "Hello World", got, w)
+		}
+	}
+}
+
+func TestMakeUniqueMaxLength(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		maxLength     int
+		smartTruncate bool
+		suffix        SuffixFunc
+		in            string
+		want          *regexp.Regexp
+	}{
+		{12, true, nil, "Hello World", regexp.MustCompile(`^hello-2$`)},
+		{13, true, nil, "Hello World", regexp.MustCompile(`^hello-world-2$`)},
+		{9, false, nil, "Hello World", regexp.MustCompile(`^hello-w-2$`)},
+		{7, false, nil, "Hello World", regexp.MustCompile(`^hello-2$`)},
+		{14, true, RandomSuffix(6), "Hello World", regexp.MustCompile(`^hello-[0-9a-z]{6}$`)},
+		{16, true, HashSuffix(4), "Hello World", regexp.MustCompile(`^hello-world-[0-9a-z]{4}$`)},
+		{3, true, HashSuffix(4), "Hello World", regexp.MustCompile(`^[0-9a-z]{3}$`)},
+	}
+
+	for index, st := range testCases {
+		c := DefaultConfig()
+		c.MaxLength = st.maxLength
+		c.EnableSmartTruncate = st.smartTruncate
+		c.UniqueSuffix = st.suffix
+		s := New(c)
+		store := NewMemoryStore()
+		if _, err := s.MakeUnique(ctx, st.in, store); err != nil {
+			t.Fatalf("%d. MakeUnique() error = %v", index, err)
+		}
+		got, err := s.MakeUnique(ctx, st.in, store)
+		if err != nil {
+			t.Fatalf("%d. MakeUnique() error = %v", index, err)
+		}
+		if !st.want.MatchString(got) || len(got) > st.maxLength {
+			t.Errorf(
+				"%d. MaxLength = %v; MakeUnique(%#v) = %#v; want %v",
+				index, st.maxLength, st.in, got, st.want)
+		}
+	}
+}
+
+func TestMakeUniqueTimestamp(t *testing.T) {
+	ctx := context.Background()
+	c := DefaultConfig()
+	c.MaxLength = 20
+	c.AppendTimestamp = true
+	c.Clock = ClockFunc(func() time.Time { return time.Unix(1792268130, 0) })
+	s := New(c)
+	store := NewMemoryStore()
+
+	for _, want := range []string{"hello-1792268130", "hello-1792268130-2"} {
+		got, err := s.MakeUnique(ctx, "Hello World", store)
+		if err != nil {
+			t.Fatalf("MakeUnique() error = %v", err)
+		}
+		if got != want {
+			t.Errorf("MakeUnique(%#v) = %#v; want %#v", "Hello World", got, want)
+		}
+	}
+}
+
+func TestMakeUniqueHashDeterministic(t *testing.T) {
+	ctx := context.Background()
+	c := DefaultConfig()
+	c.UniqueSuffix = HashSuffix(6)
+	s := New(c)
+
+	var got [2][]string
+	for i := range got {
+		store := NewMemoryStore()
+		for j := 0; j < 3; j++ {
+			slug, err := s.MakeUnique(ctx, "Hello", store)
+			if err != nil {
+				t.Fatalf("MakeUnique() error = %v", err)
+			}
+			got[i] = append(got[i], slug)
+		}
+	}
+	for j := range got[0] {
+		if got[0][j] != got[1][j] {
+			t.Errorf("%d. MakeUnique() = %#v and %#v; want equal", j, got[0][j], got[1][j])
+		}
+	}
+	if got[0][1] == got[0][2] {
+		t.Errorf("MakeUnique() returned %#v twice", got[0][1])
+	}
+}
+
+func TestSuffixPanic(t *testing.T) {
+	testCases := []func(){
+		func() { RandomSuffix(0) },
+		func() { HashSuffix(-1) },
+	}
+
+	for index, f := range testCases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%d. suffix with length <= 0 didn't panic", index)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func TestMakeUniqueScope(t *testing.T) {
+	store := NewMemoryStore()
+	tenantA := WithScope(context.Background(), "a")
+	tenantB := WithScope(context.Background(), "b")
+
+	testCases := []struct {
+		ctx  context.Context
+		want string
+	}{
+		{tenantA, "about"},
+		{tenantB, "about"},
+		{tenantA, "about-2"},
+		{context.Background(), "about"},
+		{tenantB, "about-2"},
+	}
+
+	for index, st := range testCases {
+		got, err := MakeUnique(st.ctx, "About", store)
+		if err != nil {
+			t.Fatalf("%d. MakeUnique() error = %v", index, err)
+		}
+		if got != st.want {
+			t.Errorf(
+				"%d. scope %#v; MakeUnique(%#v) = %#v; want %#v",
+				index, ScopeFromContext(st.ctx), "About", got, st.want)
+		}
+	}
+}
+
+// existsStore implements only Store.
+type existsStore map[string]bool
+
+func (e existsStore) Exists(ctx context.Context, slug string) (bool, error) {
+	return e[slug], nil
+}
+
+// errorStore fails every call.
+type errorStore struct{ err error }
+
+func (e errorStore) Exists(ctx context.Context, slug string) (bool, error) {
+	return false, e.err
+}
+
+func TestMakeUniqueExistsOnlyStore(t *testing.T) {
+	store := existsStore{"hello": true, "hello-2": true}
+	got, err := MakeUnique(context.Background(), "Hello", store)
+	if err != nil {
+		t.Fatalf("MakeUnique() error = %v", err)
+	}
+	if got != "hello-3" {
+		t.Errorf("MakeUnique(%#v) = %#v; want %#v", "Hello", got, "hello-3")
+	}
+}
+
+func TestMakeUniqueErrors(t *testing.T) {
+	storeErr := errors.New("store down")
+	if _, err := MakeUnique(context.Background(), "Hello", errorStore{storeErr}); err != storeErr {
+		t.Errorf("MakeUnique() error = %v; want %v", err, storeErr)
+	}
+
+	c := DefaultConfig()
+	c.UniqueMaxAttempts = 2
+	s := New(c)
+	store := NewMemoryStore()
+	for i := 0; i < 3; i++ {
+		if _, err := s.MakeUnique(context.Background(), "Hello", store); err != nil {
+			t.Fatalf("%d. MakeUnique() error = %v", i, err)
+		}
+	}
+	if _, err := s.MakeUnique(context.Background(), "Hello", store); err != ErrNoUniqueSlug {
+		t.Errorf("MakeUnique() error = %v; want %v", err, ErrNoUniqueSlug)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := s.MakeUnique(ctx, "Hello", store); err != context.Canceled {
+		t.Errorf("MakeUnique() error = %v; want %v", err, context.Canceled)
+	}
+}
+
+func TestMakeUniqueConcurrent(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		seen = map[string]bool{}
+	)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got, err := MakeUnique(ctx, "Hello", store)
+			if err != nil {
+				t.Errorf("MakeUnique() error = %v", err)
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if seen[got] {
+				t.Errorf("MakeUnique() returned %#v twice", got)
+			}
+			seen[got] = true
+		}()
+	}
+	wg.Wait()
+}
+
+func TestMemoryStoreRelease(t *testing.T) {
+	ctx := context.Background()
+	var store MemoryStore
+
+	if ok, _ := store.Reserve(ctx, "hello"); !ok {
+		t.Fatalf("Reserve(%#v) = false; want true", "hello")
+	}
+	if ok, _ := store.Exists(ctx, "hello"); !ok {
+		t.Errorf("Exists(%#v) = false; want true", "hello")
+	}
+	store.Release(ctx, "hello")
+	if ok, _ := store.Exists(ctx, "hello"); ok {
+		t.Errorf("Exists(%#v) = true; want false", "hello")
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+)
+
+// Errors returned by Validate, wrapped in *ValidationError.
+// Use errors.Is to check the reason.
+var (
+	ErrEmpty             = errors.New("slug is empty")
+	ErrTooLong           = errors.New("slug is too long")
+	ErrInvalidChar       = errors.New("slug contains invalid character")
+	ErrUppercase         = errors.New("slug contains upper case letter")
+	ErrLeadingSeparator  = errors.New("slug starts with separator")
+	ErrTrailingSeparator = errors.New("slug ends with separator")
+	ErrRepeatedSeparator = errors.New("slug contains repeated separator")
+	ErrReserved          = errors.New("slug is reserved")
+)
+
+// ValidationError describes why text isn't a valid slug.
+type ValidationError struct {
+	// Err is the reason, one of the Err variables.
+	Err error
+	// Pos is index of the invalid character in runes, counted from 0.
+	// It's -1 if the error isn't about a single character.
+	Pos int
+	// Char is the invalid character, if any.
+	Char rune
+	// Length is length of the text and MaxLength the allowed maximum,
+	// measured in Config.LengthUnit. They are set only for ErrTooLong.
+	Length, MaxLength int
+	// Word is the matching entry of Config.Reserved, set only for
+	// ErrReserved.
+	Word string
+}
+
+func (e *ValidationError) Error() string {
+	switch {
+	case e.Err == ErrTooLong:
+		return fmt.Sprintf("slug: %v: %d > %d", e.Err, e.Length, e.MaxLength)
+	case e.Err == ErrReserved:
+		return fmt.Sprintf("slug: %v: %q", e.Err, e.Word)
+	case e.Pos >= 0:
+		return fmt.Sprintf("slug: %v %q at position %d", e.Err, e.Char, e.Pos)
+	}
+	return "slug: " + e.Err.Error()
+}
+
+// Unwrap returns the reason of the error.
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// Validate returns nil if provided text could be returned by Make with
+// the package level options, or *ValidationError describing the first
+// problem found.
+func Validate(text string) error {
+	return globalSlugger().Validate(text)
+}
+
+// Validate returns nil if provided text could be returned by the Slugger,
+// or *ValidationError describing the first problem found.
+// Config options are taken into account, e.g. upper case letters are
+// allowed when Lowercase is false, and repeated separators when
+// DisableMultipleDashTrim is true. Slugs listed in Reserved are reported
+// with ErrReserved.
+func (s *Slugger) Validate(text string) error {
+	return s.validate(text, s.cfg.Unicode)
+}
+
+func (s *Slugger) validate(text string, unicodeMode bool) error {
+	c := &s.cfg
+	if text == "" {
+		return &ValidationError{Err: ErrEmpty, Pos: -1}
+	}
+	if c.MaxLength > 0 {
+		if n := s.length(text); n > c.MaxLength {
+			return &ValidationError{Err: ErrTooLong, Pos: -1, Length: n, MaxLength: c.MaxLength}
+		}
+	}
+	if !c.DisableEndsTrim {
+		if strings.HasPrefix(text, s.sep) || text[0] == '_' {
+			return &ValidationError{Err: ErrLeadingSeparator, Pos: 0, Char: firstRune(text)}
+		}
+		if strings.HasSuffix(text, s.sep) || text[len(text)-1] == '_' {
+			r, _ := utf8.DecodeLastRuneInString(text)
+			return &ValidationError{Err: ErrTrailingSeparator, Pos: utf8.RuneCountInString(text) - 1, Char: r}
+		}
+	}
+
+	afterSep, inWord := false, false
+	for i, pos := 0, 0; i < len(text); pos++ {
+		if strings.HasPrefix(text[i:], s.sep) {
+			if afterSep && !c.DisableMultipleDashTrim {
+				return &ValidationError{Err: ErrRepeatedSeparator, Pos: pos, Char: firstRune(text[i:])}
+			}
+			afterSep, inWord = true, false
+			pos += utf8.RuneCountInString(s.sep) - 1
+			i += len(s.sep)
+			continue
+		}
+		afterSep = false
+
+		r, size := utf8.DecodeRuneInString(text[i:])
+		if err := s.validateRune(r, unicodeMode); err != nil {
+			return &ValidationError{Err: err, Pos: pos, Char: r}
+		}
+		// Combining marks must follow a letter or number.
+		if unicode.Is(unicode.M, r) && (!inWord || isVariationSelector(r)) {
+			return &ValidationError{Err: ErrInvalidChar, Pos: pos, Char: r}
+		}
+		inWord = r != '_'
+		i += size
+	}
+	if word := s.reserved(text); word != "" {
+		return &ValidationError{Err: ErrReserved, Pos: -1, Word: word}
+	}
+	return nil
+}
+
+// validateRune returns reason why the rune can't be a part of a slug
+// outside of separators, or nil.
+func (s *Slugger) validateRune(r rune, unicodeMode bool) error {
+	switch {
+	case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+		return nil
+	case r == '_':
+		if s.cfg.Underscore == UnderscoreKeep {
+			return nil
+		}
+	case r >= 'A' && r <= 'Z':
+		if !s.cfg.Lowercase {
+			return nil
+		}
+		return ErrUppercase
+	case unicodeMode && r >= utf8.RuneSelf && unicode.In(r, unicode.L, unicode.N, unicode.M):
+		if s.cfg.Lowercase && (unicode.IsUpper(r) || unicode.IsTitle(r) || !isFolded(r)) {
+			return ErrUppercase
+		}
+		return nil
+	}
+	return ErrInvalidChar
+}
+
+// isFolded reports whether the rune is left as is by Unicode case folding,
+// e.g. "ß" isn't, as it's folded to "ss".
+func isFolded(r rune) bool {
+	return cases.Fold().String(string(r)) == string(r)
+}
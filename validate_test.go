@@ -0,0 +1,128 @@
+// Copyright 2013 by Dobrosław Żybort. All rights reserved.
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package slug
+
+import (
+	"errors"
+	"testing"
+)
+
+//=============================================================================
+
+func TestSluggerValidate(t *testing.T) {
+	testCases := []struct {
+		config  func(c *Config)
+		in      string
+		wantErr error
+		wantPos int
+	}{
+		{nil, "some-more_2", nil, 0},
+		{nil, "", ErrEmpty, -1},
+		{func(c *Config) { c.MaxLength = 4 }, "012345", ErrTooLong, -1},
+		{func(c *Config) { c.MaxLength = 4 }, "0123", nil, 0},
+		{nil, "-some", ErrLeadingSeparator, 0},
+		{nil, "_some", ErrLeadingSeparator, 0},
+		{nil, "some-", ErrTrailingSeparator, 4},
+		{nil, "some--more", ErrRepeatedSeparator, 5},
+		{nil, "some more", ErrInvalidChar, 4},
+		{nil, "żółw-2", ErrInvalidChar, 0},
+		{nil, "some-More", ErrUppercase, 5},
+		{func(c *Config) { c.Lowercase = false }, "Some-More", nil, 0},
+		{func(c *Config) { c.DisableEndsTrim = true }, "-some_", nil, 0},
+		{func(c *Config) { c.DisableMultipleDashTrim = true }, "some--more", nil, 0},
+		{func(c *Config) { c.Underscore = UnderscoreRemove }, "some_more", ErrInvalidChar, 4},
+		{func(c *Config) { c.Separator = "::" }, "some::more", nil, 0},
+		{func(c *Config) { c.Separator = "::" }, "some::::more", ErrRepeatedSeparator, 6},
+		{func(c *Config) { c.Separator = "::" }, "some-more", ErrInvalidChar, 4},
+		{func(c *Config) { c.Unicode = true }, "żółw-2", nil, 0},
+		{func(c *Config) { c.Unicode = true }, "żółw-Ż", ErrUppercase, 5},
+		{func(c *Config) { c.Unicode = true; c.Lowercase = false }, "żółw-Ż", nil, 0},
+	}
+
+	for index, st := range testCases {
+		c := DefaultConfig()
+		if st.config != nil {
+			st.config(&c)
+		}
+		s := New(c)
+		err := s.Validate(st.in)
+		if !errors.Is(err, st.wantErr) {
+			t.Errorf("%d. Validate(%#v) = %v; want %v", index, st.in, err, st.wantErr)
+			continue
+		}
+		if err == nil {
+			if !s.IsSlug(st.in) {
+				t.Errorf("%d. IsSlug(%#v) = false; want true", index, st.in)
+			}
+			continue
+		}
+		var verr *ValidationError
+		if !errors.As(err, &verr) || verr.Pos != st.wantPos {
+			t.Errorf("%d. Validate(%#v) = %#v; want position %v", index, st.in, err, st.wantPos)
+		}
+		if s.IsSlug(st.in) {
+			t.Errorf("%d. IsSlug(%#v) = true; want false", index, st.in)
+		}
+	}
+}
+
+func TestValidateMessage(t *testing.T) {
+	testCases := []struct {
+		in   string
+		want string
+	}{
+		{"", "slug: slug is empty"},
+		{"some more", "slug: slug contains invalid character ' ' at position 4"},
+		{"abc-Def", "slug: slug contains upper case letter 'D' at position 4"},
+		{"abc-", "slug: slug ends with separator '-' at position 3"},
+	}
+
+	for index, st := range testCases {
+		if err := Validate(st.in); err == nil || err.Error() != st.want {
+			t.Errorf("%d. Validate(%#v) = %v; want %v", index, st.in, err, st.want)
+		}
+	}
+
+	c := DefaultConfig()
+	c.MaxLength = 3
+	want := "slug: slug is too long: 4 > 3"
+	if err := New(c).Validate("abcd"); err == nil || err.Error() != want {
+		t.Errorf("Validate(%#v) = %v; want %v", "abcd", err, want)
+	}
+}
+
+func TestSluggerValidateMakeOutput(t *testing.T) {
+	inputs := []string{
+		"Hello World", "  --Hello__World--  ", "Dobrosław Żybort", "東京 旅行",
+		"a & b", "100% Cotton 🔥", "The quick brown fox jumps over the lazy dog",
+		"\tä!_/'&1🇩🇪ä--", "Straße_ über", "ab_ cd_ ef",
+	}
+	configs := []func(c *Config){
+		func(c *Config) {},
+		func(c *Config) { c.Lowercase = false },
+		func(c *Config) { c.Unicode = true; c.MaxLength = 10 },
+		func(c *Config) { c.Unicode = true; c.MaxLength = 5 },
+		func(c *Config) { c.Unicode = true; c.MaxLength = 5; c.EnableSmartTruncate = false },
+		func(c *Config) { c.Separator = "·"; c.MaxLength = 3 },
+		func(c *Config) { c.Separator = "::"; c.Underscore = UnderscoreToSeparator },
+		func(c *Config) { c.MaxLength = 12; c.HashTruncate = true },
+		func(c *Config) { c.DisableMultipleDashTrim = true; c.DisableEndsTrim = true },
+	}
+
+	for i, config := range configs {
+		c := DefaultConfig()
+		config(&c)
+		s := New(c)
+		for _, in := range inputs {
+			for _, lang := range []string{"en", "de"} {
+				slug := s.MakeLang(in, lang)
+				if err := s.Validate(slug); slug != "" && err != nil {
+					t.Errorf("%d. Validate(MakeLang(%#v, %#v)) = %v; want nil", i, in, lang, err)
+				}
+			}
+		}
+	}
+}